@@ -19,6 +19,16 @@ type DbAsserts struct {
 	T       TestingT
 	Db      *sql.DB
 	Dialect string
+
+	// Schema is the default schema used to resolve unqualified names. When
+	// it's empty, an unqualified name must exist in exactly one schema.
+	Schema string
+
+	// EvaluateDefaults has Column evaluate column defaults whose normalized
+	// text doesn't match in a transaction which is rolled back, and compare
+	// their values. Volatile defaults, such as nextval, aren't evaluated,
+	// since sequences aren't rolled back, and are only compared as text.
+	EvaluateDefaults bool
 }
 
 // New creates a new DbAsserts.
@@ -56,6 +66,12 @@ type THelper interface {
 	Helper()
 }
 
+// TLogger is the logging interface used by the dbassert package to report
+// details about successful assertions.
+type TLogger interface {
+	Logf(format string, args ...interface{})
+}
+
 func isNil(i interface{}) bool {
 	if i == nil {
 		return true
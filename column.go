@@ -5,20 +5,30 @@ package dbassert
 
 import (
 	"database/sql"
+	"fmt"
+	"strconv"
 	"strings"
 
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
 // ColumnInfo defines a set of information about a column.
 type ColumnInfo struct {
-	// TableName for the column.
+	// Schema of the column's table. When empty, the schema is resolved
+	// from TableName or the DbAsserts Schema and isn't compared by Column.
+	Schema string
+
+	// TableName for the column, optionally qualified with its schema
+	// (schema.table).
 	TableName string
 
 	// Name of the column.
 	Name string
 
-	// Default value for the column.
+	// Default value for the column. It's compared after being normalized by
+	// the database, so casts such as 'x'::text may be omitted, and now() is
+	// equivalent to CURRENT_TIMESTAMP. See DbAsserts EvaluateDefaults.
 	Default string
 
 	// Type of the column.
@@ -29,6 +39,88 @@ type ColumnInfo struct {
 
 	// IsNullable defines if the column can be null.
 	IsNullable bool
+
+	// Identity of the column. When its Generation is empty, it isn't
+	// compared by Column.
+	Identity IdentityInfo
+
+	// UdtName is the name of the column's underlying type, for example
+	// varchar, int4 or _text for text[]. When empty, it isn't compared by
+	// Column.
+	UdtName string
+
+	// ElementType is the element type of an array column, for example text
+	// for text[]. When empty, it isn't compared by Column.
+	ElementType string
+
+	// CharMaxLength is the maximum length of a character column, for
+	// example 32 for varchar(32), or zero when it has none. When zero, it
+	// isn't compared by Column unless CompareModifiers is set.
+	CharMaxLength int64
+
+	// NumericPrecision is the precision of a numeric column, for example 10
+	// for numeric(10,2), or zero when it has none. When zero, it isn't
+	// compared by Column unless CompareModifiers is set.
+	NumericPrecision int64
+
+	// NumericScale is the scale of a numeric column, for example 2 for
+	// numeric(10,2), or zero when it has none. When zero, it isn't compared
+	// by Column unless CompareModifiers is set.
+	NumericScale int64
+
+	// DatetimePrecision is the fractional seconds precision of a date, time
+	// or interval column, for example 0 for timestamp(0). When zero, it
+	// isn't compared by Column unless CompareModifiers is set.
+	DatetimePrecision int64
+
+	// CompareModifiers defines if CharMaxLength, NumericPrecision,
+	// NumericScale and DatetimePrecision are compared even when zero, so a
+	// column without a modifier, such as an unconstrained numeric, can be
+	// asserted. They're compared with information_schema.columns, which
+	// reports a precision for every numeric and datetime type, for example
+	// 32 for integer and 6 for timestamp.
+	CompareModifiers bool
+
+	// Collation of the column when it isn't the default collation. When
+	// empty, it isn't compared by Column.
+	Collation string
+
+	// IsGenerated defines if the column is a generated column. When false,
+	// it isn't compared by Column.
+	IsGenerated bool
+
+	// GenerationExpression of a generated column. When empty, it isn't
+	// compared by Column, otherwise it's compared after being normalized by
+	// the database, so it doesn't need to match the text returned by
+	// information_schema.columns.
+	GenerationExpression string
+}
+
+// defaultEquivalents replaces functions which are equivalent as column
+// defaults but rendered differently by the database.
+var defaultEquivalents = strings.NewReplacer(
+	"now()", "CURRENT_TIMESTAMP",
+	"transaction_timestamp()", "CURRENT_TIMESTAMP",
+)
+
+// columnScratchName names the scratch table and column used to normalize
+// column expressions.
+const columnScratchName = "dbassert_column"
+
+// IdentityInfo defines the identity of a column generated as identity.
+type IdentityInfo struct {
+	// Generation of the identity: ALWAYS or BY DEFAULT. Empty when the
+	// column isn't an identity column.
+	Generation string
+
+	// Start value of the identity's sequence.
+	Start int64
+
+	// Increment of the identity's sequence.
+	Increment int64
+
+	// IsCycle defines if the identity's sequence cycles.
+	IsCycle bool
 }
 
 // Nullable asserts colName in tableName is nullable.
@@ -48,6 +140,23 @@ func (a *DbAsserts) Nullable(tableName, colName string) bool {
 	return false
 }
 
+// NotNullable asserts colName in tableName is not nullable.
+func (a *DbAsserts) NotNullable(tableName, colName string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	dbColumn, err := a.getSchemaInfo(tableName, colName)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	if !dbColumn.IsNullable {
+		return true
+	}
+	assert.Fail(a.T, "column is nullable", "%s: %s is nullable", tableName, colName)
+	return false
+}
+
 // Domain asserts colName in tableName is domainName.
 func (a *DbAsserts) Domain(tableName, colName, domainName string) bool {
 	if h, ok := a.T.(THelper); ok {
@@ -65,16 +174,71 @@ func (a *DbAsserts) Domain(tableName, colName, domainName string) bool {
 	return false
 }
 
+// NotDomain asserts colName in tableName is not domainName.
+func (a *DbAsserts) NotDomain(tableName, colName, domainName string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	dbColumn, err := a.getSchemaInfo(tableName, colName)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	if !strings.EqualFold(domainName, dbColumn.DomainName) {
+		return true
+	}
+	assert.Fail(a.T, "domain is not valid", "%s: %s is %s", tableName, colName, domainName)
+	return false
+}
+
+// TypeIs asserts colName in tableName is typeName, as returned by
+// format_type, for example integer, text[] or the name of a domain.
+// typeName is normalized by the database, so int matches integer and
+// varchar(32) matches character varying(32). When typeName has modifiers
+// they're compared too, otherwise any modifiers of colName are ignored.
+func (a *DbAsserts) TypeIs(tableName, colName, typeName string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	dbType, dbUnmodifiedType, err := a.getColumnFormatType(tableName, colName)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	want, hasModifiers, err := a.normalizeColumnType(typeName)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	if !hasModifiers {
+		if want, err = a.normalizeType(typeName); err != nil {
+			assert.FailNow(a.T, err.Error())
+			return false
+		}
+		dbType = dbUnmodifiedType
+	}
+	if want == dbType {
+		return true
+	}
+	assert.Fail(a.T, "type is not valid", "%s: %s is %s not %s", tableName, colName, dbType, typeName)
+	return false
+}
+
 // Column asserts c ColumnInfo is valid.
 func (a *DbAsserts) Column(c ColumnInfo) bool {
 	if h, ok := a.T.(THelper); ok {
 		h.Helper()
 	}
-	dbColumn, err := a.getSchemaInfo(c.TableName, c.Name)
+	dbColumn, err := a.getSchemaInfo(qualifyName(c.Schema, c.TableName), c.Name)
 	if err != nil {
 		assert.FailNow(a.T, err.Error())
 		return false
 	}
+	if c, err = a.wantColumn(c, dbColumn); err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	dbColumn = comparableColumn(c, dbColumn)
 	if c != *dbColumn {
 		assert.Fail(a.T, "invalid column", "%s: %+v column is not valid in the db column %+v", c.TableName, c, dbColumn)
 		return false
@@ -82,25 +246,159 @@ func (a *DbAsserts) Column(c ColumnInfo) bool {
 	return true
 }
 
-func (a *DbAsserts) getSchemaInfo(tableName, columnName string) (*ColumnInfo, error) {
-	if h, ok := a.T.(THelper); ok {
-		h.Helper()
+// wantColumn returns c normalized to be compared with dbColumn.
+func (a *DbAsserts) wantColumn(c ColumnInfo, dbColumn *ColumnInfo) (ColumnInfo, error) {
+	var err error
+	c.Identity.Generation = strings.ToUpper(c.Identity.Generation)
+	if c.Default != "" && c.Default != dbColumn.Default {
+		if c.Default, err = a.matchDefault(dbColumn, c.Default); err != nil {
+			return c, err
+		}
 	}
-	const query = `
+	if c.GenerationExpression != "" {
+		if c.GenerationExpression, err = a.normalizeGenerationExpr(dbColumn, c.GenerationExpression); err != nil {
+			return c, err
+		}
+	}
+	return c, nil
+}
+
+// comparableColumn returns a copy of dbColumn without the fields which
+// aren't compared with c, so existing ColumnInfo values without the newer
+// fields keep matching.
+func comparableColumn(c ColumnInfo, dbColumn *ColumnInfo) *ColumnInfo {
+	db := *dbColumn
+	db.TableName = c.TableName
+	if c.Schema == "" {
+		db.Schema = ""
+	}
+	if c.Identity.Generation == "" {
+		db.Identity = IdentityInfo{}
+	}
+	if c.UdtName == "" {
+		db.UdtName = ""
+	}
+	if c.ElementType == "" {
+		db.ElementType = ""
+	}
+	db.CompareModifiers = c.CompareModifiers
+	if !c.CompareModifiers {
+		if c.CharMaxLength == 0 {
+			db.CharMaxLength = 0
+		}
+		if c.NumericPrecision == 0 {
+			db.NumericPrecision = 0
+		}
+		if c.NumericScale == 0 {
+			db.NumericScale = 0
+		}
+		if c.DatetimePrecision == 0 {
+			db.DatetimePrecision = 0
+		}
+	}
+	if c.Collation == "" {
+		db.Collation = ""
+	}
+	if !c.IsGenerated {
+		db.IsGenerated = false
+	}
+	if c.GenerationExpression == "" {
+		db.GenerationExpression = ""
+	}
+	return &db
+}
+
+// columnsQuery selects the columns of the table $2 in the schema $1 from
+// information_schema.columns, to be scanned by scanColumn.
+const columnsQuery = `
 select 
-	table_name, 
 	column_name, 
 	column_default, 
 	data_type, 
 	domain_name,
-	is_nullable
+	is_nullable,
+	identity_generation,
+	identity_start,
+	identity_increment,
+	identity_cycle,
+	udt_name,
+	coalesce((
+		select format_type(t.typelem, null)
+		from pg_type t
+		join pg_namespace tn on tn.oid = t.typnamespace
+		where tn.nspname = udt_schema
+			and t.typname = udt_name
+			and t.typelem <> 0
+			and t.typlen = -1
+	), ''),
+	coalesce(character_maximum_length, 0),
+	coalesce(numeric_precision, 0),
+	coalesce(numeric_scale, 0),
+	coalesce(datetime_precision, 0),
+	coalesce(collation_name, ''),
+	is_generated,
+	coalesce(generation_expression, '')
 from information_schema.columns
-where table_name = $1 and column_name = $2`
-	row := a.Db.QueryRow(query, tableName, columnName)
+where table_schema = $1 and table_name = $2`
+
+func (a *DbAsserts) getSchemaInfo(tableName, columnName string) (*ColumnInfo, error) {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, table, err := a.resolveTable(tableName)
+	if err != nil {
+		return nil, err
+	}
+	row := a.Db.QueryRow(columnsQuery+" and column_name = $3", schema, table, columnName)
+	c, err := scanColumn(row, schema, tableName)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("%s: column %s not found", tableName, columnName)
+	}
+	return c, err
+}
 
-	var table, colName, colType, colIsNullable string
+// getTableColumns returns the columns of tableName in their ordinal order.
+func (a *DbAsserts) getTableColumns(tableName string) ([]ColumnInfo, error) {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, table, err := a.resolveTable(tableName)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := a.Db.Query(columnsQuery+" order by ordinal_position", schema, table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var columns []ColumnInfo
+	for rows.Next() {
+		c, err := scanColumn(rows, schema, tableName)
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, *c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("table %s not found", tableName)
+	}
+	return columns, nil
+}
+
+// scanColumn scans a row selected by columnsQuery.
+func scanColumn(row interface{ Scan(...interface{}) error }, schema, tableName string) (*ColumnInfo, error) {
+	var colName, colType, colIsNullable string
 	var colDefault, colDomainName sql.NullString
-	if err := row.Scan(&table, &colName, &colDefault, &colType, &colDomainName, &colIsNullable); err != nil {
+	var idGeneration, idStart, idIncrement, idCycle sql.NullString
+	var udtName, elementType, collation, isGenerated, generationExpr string
+	var charMaxLength, numericPrecision, numericScale, datetimePrecision int64
+	if err := row.Scan(&colName, &colDefault, &colType, &colDomainName, &colIsNullable,
+		&idGeneration, &idStart, &idIncrement, &idCycle,
+		&udtName, &elementType, &charMaxLength, &numericPrecision, &numericScale, &datetimePrecision, &collation,
+		&isGenerated, &generationExpr); err != nil {
 		return nil, err
 	}
 
@@ -108,16 +406,193 @@ where table_name = $1 and column_name = $2`
 	if colIsNullable == "YES" {
 		nullable = true
 	}
+	var identity IdentityInfo
+	if idGeneration.Valid {
+		start, err := strconv.ParseInt(NullableString(idStart), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		increment, err := strconv.ParseInt(NullableString(idIncrement), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		identity = IdentityInfo{
+			Generation: idGeneration.String,
+			Start:      start,
+			Increment:  increment,
+			IsCycle:    NullableString(idCycle) == "YES",
+		}
+	}
 	return &ColumnInfo{
-		TableName:  tableName,
-		Name:       colName,
-		Default:    NullableString(colDefault),
-		Type:       colType,
-		DomainName: NullableString(colDomainName),
-		IsNullable: nullable,
+		Schema:               schema,
+		TableName:            tableName,
+		Name:                 colName,
+		Default:              NullableString(colDefault),
+		Type:                 colType,
+		DomainName:           NullableString(colDomainName),
+		IsNullable:           nullable,
+		Identity:             identity,
+		UdtName:              udtName,
+		ElementType:          elementType,
+		CharMaxLength:        charMaxLength,
+		NumericPrecision:     numericPrecision,
+		NumericScale:         numericScale,
+		DatetimePrecision:    datetimePrecision,
+		Collation:            collation,
+		IsGenerated:          isGenerated == "ALWAYS",
+		GenerationExpression: generationExpr,
 	}, nil
 }
 
+// getColumnFormatType returns the type of colName in tableName as returned
+// by format_type, with and without its modifiers.
+func (a *DbAsserts) getColumnFormatType(tableName, colName string) (string, string, error) {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, table, err := a.resolveTable(tableName)
+	if err != nil {
+		return "", "", err
+	}
+	const query = `
+select format_type(a.atttypid, a.atttypmod), format_type(a.atttypid, null)
+from pg_attribute a
+join pg_class c on c.oid = a.attrelid
+join pg_namespace n on n.oid = c.relnamespace
+where n.nspname = $1
+	and c.relname = $2
+	and a.attname = $3
+	and a.attnum > 0
+	and not a.attisdropped`
+	var colType, unmodifiedType string
+	err = a.Db.QueryRow(query, schema, table, colName).Scan(&colType, &unmodifiedType)
+	switch {
+	case err == sql.ErrNoRows:
+		return "", "", fmt.Errorf("%s: column %s not found", tableName, colName)
+	case err != nil:
+		return "", "", err
+	}
+	return colType, unmodifiedType, nil
+}
+
+// normalizeGenerationExpr has the database render expr as the generation
+// expression of a column like c.
+func (a *DbAsserts) normalizeGenerationExpr(c *ColumnInfo, expr string) (string, error) {
+	return a.normalizeColumnExpr(c, expr, fmt.Sprintf("generated always as (%s) stored", expr))
+}
+
+// volatileDefaultsQuery finds if a default of the scratch table %s calls a
+// volatile function, using the function oids of the defaults' expression
+// trees, since dependencies on built-in functions aren't recorded.
+const volatileDefaultsQuery = `
+select exists(
+	select 1
+	from pg_attrdef ad
+	cross join lateral regexp_matches(ad.adbin::text, ':(?:op)?funcid (\d+)', 'g') as f(m)
+	join pg_proc p on p.oid = f.m[1]::oid
+	where ad.adrelid = 'pg_temp.%s'::regclass
+		and p.provolatile = 'v'
+)`
+
+// matchDefault returns the default of c when expr is an equivalent default,
+// otherwise expr normalized by the database. Defaults are equivalent when
+// their normalized text matches, ignoring defaultEquivalents, or, when
+// EvaluateDefaults is set and neither is volatile, when they evaluate to the
+// same value.
+func (a *DbAsserts) matchDefault(c *ColumnInfo, expr string) (string, error) {
+	if c.Default == "" {
+		return expr, nil
+	}
+	normalized, err := a.normalizeColumnExpr(c, expr, fmt.Sprintf("default %s", expr))
+	if err != nil {
+		return "", err
+	}
+	if defaultEquivalents.Replace(normalized) == defaultEquivalents.Replace(c.Default) {
+		return c.Default, nil
+	}
+	if !a.EvaluateDefaults {
+		return normalized, nil
+	}
+	var equal bool
+	err = a.withRollback(func(tx *sql.Tx) error {
+		colType, err := columnType(tx, c)
+		if err != nil {
+			return err
+		}
+		create := fmt.Sprintf("create temp table %s (want %[2]s default %[3]s, got %[2]s default %[4]s)",
+			columnScratchName, colType, normalized, c.Default)
+		if _, err := tx.Exec(create); err != nil {
+			return fmt.Errorf("unable to evaluate default %q: %w", expr, err)
+		}
+		// volatile defaults, such as nextval, may change the database in
+		// ways the rollback doesn't undo, so they aren't evaluated.
+		var volatile bool
+		if err := tx.QueryRow(fmt.Sprintf(volatileDefaultsQuery, columnScratchName)).Scan(&volatile); err != nil {
+			return err
+		}
+		if volatile {
+			return nil
+		}
+		if _, err := tx.Exec(fmt.Sprintf("insert into %s default values", columnScratchName)); err != nil {
+			return fmt.Errorf("unable to evaluate default %q: %w", expr, err)
+		}
+		query := fmt.Sprintf("select want is not distinct from got from pg_temp.%s", columnScratchName)
+		return tx.QueryRow(query).Scan(&equal)
+	})
+	if err != nil {
+		return "", err
+	}
+	if equal {
+		return c.Default, nil
+	}
+	return normalized, nil
+}
+
+// normalizeColumnExpr has the database render expr, the expression of the
+// column definition clause, by adding a column like c with clause to a
+// scratch copy of c's table.
+func (a *DbAsserts) normalizeColumnExpr(c *ColumnInfo, expr, clause string) (string, error) {
+	var normalized string
+	err := a.withRollback(func(tx *sql.Tx) error {
+		colType, err := columnType(tx, c)
+		if err != nil {
+			return err
+		}
+		create := fmt.Sprintf("create temp table %[1]s (like %[2]s); alter table %[1]s add column %[1]s %[3]s %[4]s",
+			columnScratchName, quoteTable(c), colType, clause)
+		if _, err := tx.Exec(create); err != nil {
+			return fmt.Errorf("invalid expression %q: %w", expr, err)
+		}
+		query := fmt.Sprintf(`
+select pg_get_expr(ad.adbin, ad.adrelid)
+from pg_attrdef ad
+join pg_attribute a on a.attrelid = ad.adrelid and a.attnum = ad.adnum
+where ad.adrelid = 'pg_temp.%[1]s'::regclass and a.attname = '%[1]s'`, columnScratchName)
+		return tx.QueryRow(query).Scan(&normalized)
+	})
+	return normalized, err
+}
+
+// columnType returns the type of c as returned by format_type, including
+// its modifiers, for example character varying(32).
+func columnType(tx *sql.Tx, c *ColumnInfo) (string, error) {
+	const query = `
+select format_type(a.atttypid, a.atttypmod)
+from pg_attribute a
+where a.attrelid = $1::regclass and a.attname = $2`
+	var colType string
+	if err := tx.QueryRow(query, quoteTable(c), c.Name).Scan(&colType); err != nil {
+		return "", err
+	}
+	return colType, nil
+}
+
+// quoteTable returns the quoted, schema qualified name of c's table.
+func quoteTable(c *ColumnInfo) string {
+	_, table := splitName(c.TableName)
+	return pq.QuoteIdentifier(c.Schema) + "." + pq.QuoteIdentifier(table)
+}
+
 // NullableString is a type alias for nullable database columns for strings.
 func NullableString(value sql.NullString) string {
 	if !value.Valid {
@@ -125,3 +600,86 @@ func NullableString(value sql.NullString) string {
 	}
 	return value.String
 }
+
+// getColumns returns the columns of tableName, a table, view or
+// materialized view, in order. Unlike getSchemaInfo, which reads
+// information_schema.columns, the columns are read from pg_attribute so
+// materialized views are supported, using the same rules as
+// information_schema.columns. Identities aren't read, since views can't
+// have them.
+func (a *DbAsserts) getColumns(tableName string) ([]ColumnInfo, error) {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, table, err := a.resolveTable(tableName)
+	if err != nil {
+		return nil, err
+	}
+	const query = `
+select
+	a.attname,
+	coalesce(case when a.attgenerated = '' then pg_get_expr(ad.adbin, ad.adrelid) end, ''),
+	case
+		when t.typtype = 'd' then
+			case
+				when bt.typelem <> 0 and bt.typlen = -1 then 'ARRAY'
+				when nbt.nspname = 'pg_catalog' then format_type(t.typbasetype, null)
+				else 'USER-DEFINED'
+			end
+		else
+			case
+				when t.typelem <> 0 and t.typlen = -1 then 'ARRAY'
+				when nt.nspname = 'pg_catalog' then format_type(a.atttypid, null)
+				else 'USER-DEFINED'
+			end
+	end,
+	case when t.typtype = 'd' then t.typname else '' end,
+	not (a.attnotnull or (t.typtype = 'd' and t.typnotnull)),
+	coalesce(bt.typname, t.typname),
+	case
+		when coalesce(bt.typelem, t.typelem) <> 0 and coalesce(bt.typlen, t.typlen) = -1
+			then format_type(coalesce(bt.typelem, t.typelem), null)
+		else ''
+	end,
+	coalesce(information_schema._pg_char_max_length(information_schema._pg_truetypid(a.*, t.*), information_schema._pg_truetypmod(a.*, t.*)), 0),
+	coalesce(information_schema._pg_numeric_precision(information_schema._pg_truetypid(a.*, t.*), information_schema._pg_truetypmod(a.*, t.*)), 0),
+	coalesce(information_schema._pg_numeric_scale(information_schema._pg_truetypid(a.*, t.*), information_schema._pg_truetypmod(a.*, t.*)), 0),
+	coalesce(information_schema._pg_datetime_precision(information_schema._pg_truetypid(a.*, t.*), information_schema._pg_truetypmod(a.*, t.*)), 0),
+	coalesce(co.collname, ''),
+	a.attgenerated = 's',
+	coalesce(case when a.attgenerated <> '' then pg_get_expr(ad.adbin, ad.adrelid) end, '')
+from pg_attribute a
+join pg_class c on c.oid = a.attrelid
+join pg_namespace n on n.oid = c.relnamespace
+join pg_type t on t.oid = a.atttypid
+join pg_namespace nt on nt.oid = t.typnamespace
+left join pg_type bt on t.typtype = 'd' and bt.oid = t.typbasetype
+left join pg_namespace nbt on nbt.oid = bt.typnamespace
+left join pg_attrdef ad on ad.adrelid = a.attrelid and ad.adnum = a.attnum
+left join (pg_collation co join pg_namespace nco on nco.oid = co.collnamespace)
+	on co.oid = a.attcollation and (nco.nspname, co.collname) <> ('pg_catalog', 'default')
+where n.nspname = $1 and c.relname = $2 and a.attnum > 0 and not a.attisdropped
+order by a.attnum`
+	rows, err := a.Db.Query(query, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var columns []ColumnInfo
+	for rows.Next() {
+		c := ColumnInfo{
+			Schema:    schema,
+			TableName: tableName,
+		}
+		if err := rows.Scan(&c.Name, &c.Default, &c.Type, &c.DomainName, &c.IsNullable,
+			&c.UdtName, &c.ElementType, &c.CharMaxLength, &c.NumericPrecision, &c.NumericScale, &c.DatetimePrecision,
+			&c.Collation, &c.IsGenerated, &c.GenerationExpression); err != nil {
+			return nil, err
+		}
+		columns = append(columns, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return columns, nil
+}
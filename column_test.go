@@ -19,9 +19,10 @@ func TestDbAsserts_Column(t *testing.T) {
 		}
 	}()
 	cases := []struct {
-		name   string
-		column ColumnInfo
-		want   bool
+		name             string
+		column           ColumnInfo
+		evaluateDefaults bool
+		want             bool
 	}{
 		{
 			name: "nullable",
@@ -35,6 +36,390 @@ func TestDbAsserts_Column(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "schema",
+			column: ColumnInfo{
+				Schema:     "public",
+				TableName:  "test_table_dbasserts",
+				Name:       "public_id",
+				Default:    "",
+				Type:       "text",
+				DomainName: "dbasserts_public_id",
+				IsNullable: false,
+			},
+			want: true,
+		},
+		{
+			name: "bad schema",
+			column: ColumnInfo{
+				Schema:     "dbasserts_audit",
+				TableName:  "test_table_dbasserts",
+				Name:       "public_id",
+				Default:    "",
+				Type:       "text",
+				DomainName: "dbasserts_public_id",
+				IsNullable: false,
+			},
+			want: false,
+		},
+		{
+			name: "ambiguous table",
+			column: ColumnInfo{
+				TableName:  "test_multi_schema_dbasserts",
+				Name:       "name",
+				Type:       "text",
+				IsNullable: true,
+			},
+			want: false,
+		},
+		{
+			name: "identity",
+			column: ColumnInfo{
+				TableName: "test_table_dbasserts",
+				Name:      "id",
+				Type:      "bigint",
+				Identity: IdentityInfo{
+					Generation: "always",
+					Start:      1,
+					Increment:  1,
+				},
+			},
+			want: true,
+		},
+		{
+			name: "identity-not-compared",
+			column: ColumnInfo{
+				TableName: "test_table_dbasserts",
+				Name:      "id",
+				Type:      "bigint",
+			},
+			want: true,
+		},
+		{
+			name: "bad identity generation",
+			column: ColumnInfo{
+				TableName: "test_table_dbasserts",
+				Name:      "id",
+				Type:      "bigint",
+				Identity: IdentityInfo{
+					Generation: "BY DEFAULT",
+					Start:      1,
+					Increment:  1,
+				},
+			},
+			want: false,
+		},
+		{
+			name: "bad identity start",
+			column: ColumnInfo{
+				TableName: "test_table_dbasserts",
+				Name:      "id",
+				Type:      "bigint",
+				Identity: IdentityInfo{
+					Generation: "ALWAYS",
+					Start:      100,
+					Increment:  1,
+				},
+			},
+			want: false,
+		},
+		{
+			name: "not identity",
+			column: ColumnInfo{
+				TableName:  "test_table_dbasserts",
+				Name:       "type_int",
+				Type:       "integer",
+				IsNullable: true,
+				Identity: IdentityInfo{
+					Generation: "ALWAYS",
+					Start:      1,
+					Increment:  1,
+				},
+			},
+			want: false,
+		},
+		{
+			name: "varchar length collation",
+			column: ColumnInfo{
+				TableName:     "test_column_types_dbasserts",
+				Name:          "code",
+				Type:          "character varying",
+				IsNullable:    true,
+				UdtName:       "varchar",
+				CharMaxLength: 32,
+				Collation:     "C",
+			},
+			want: true,
+		},
+		{
+			name: "bad varchar length",
+			column: ColumnInfo{
+				TableName:     "test_column_types_dbasserts",
+				Name:          "code",
+				Type:          "character varying",
+				IsNullable:    true,
+				CharMaxLength: 255,
+			},
+			want: false,
+		},
+		{
+			name: "bad collation",
+			column: ColumnInfo{
+				TableName:  "test_column_types_dbasserts",
+				Name:       "code",
+				Type:       "character varying",
+				IsNullable: true,
+				Collation:  "POSIX",
+			},
+			want: false,
+		},
+		{
+			name: "numeric precision scale",
+			column: ColumnInfo{
+				TableName:        "test_column_types_dbasserts",
+				Name:             "amount",
+				Type:             "numeric",
+				IsNullable:       true,
+				NumericPrecision: 10,
+				NumericScale:     2,
+			},
+			want: true,
+		},
+		{
+			name: "bad numeric scale",
+			column: ColumnInfo{
+				TableName:        "test_column_types_dbasserts",
+				Name:             "amount",
+				Type:             "numeric",
+				IsNullable:       true,
+				NumericPrecision: 10,
+				NumericScale:     4,
+			},
+			want: false,
+		},
+		{
+			name: "unconstrained numeric",
+			column: ColumnInfo{
+				TableName:        "test_column_types_dbasserts",
+				Name:             "amount",
+				Type:             "numeric",
+				IsNullable:       true,
+				CompareModifiers: true,
+			},
+			want: false,
+		},
+		{
+			name: "no modifiers",
+			column: ColumnInfo{
+				TableName:        "test_column_types_dbasserts",
+				Name:             "name",
+				Type:             "text",
+				IsNullable:       true,
+				CompareModifiers: true,
+			},
+			want: true,
+		},
+		{
+			name: "unconstrained varchar",
+			column: ColumnInfo{
+				TableName:        "test_column_types_dbasserts",
+				Name:             "code",
+				Type:             "character varying",
+				IsNullable:       true,
+				CompareModifiers: true,
+			},
+			want: false,
+		},
+		{
+			name: "text array",
+			column: ColumnInfo{
+				TableName:   "test_column_types_dbasserts",
+				Name:        "tags",
+				Type:        "ARRAY",
+				IsNullable:  true,
+				UdtName:     "_text",
+				ElementType: "text",
+			},
+			want: true,
+		},
+		{
+			name: "bad array element type",
+			column: ColumnInfo{
+				TableName:   "test_column_types_dbasserts",
+				Name:        "counts",
+				Type:        "ARRAY",
+				IsNullable:  true,
+				ElementType: "text",
+			},
+			want: false,
+		},
+		{
+			name: "datetime precision",
+			column: ColumnInfo{
+				TableName:         "test_column_types_dbasserts",
+				Name:              "create_time",
+				Type:              "timestamp without time zone",
+				IsNullable:        true,
+				DatetimePrecision: 3,
+			},
+			want: true,
+		},
+		{
+			name: "generated",
+			column: ColumnInfo{
+				TableName:            "test_column_types_dbasserts",
+				Name:                 "name_length",
+				Type:                 "integer",
+				IsNullable:           true,
+				IsGenerated:          true,
+				GenerationExpression: "LENGTH( name )",
+			},
+			want: true,
+		},
+		{
+			name: "not generated",
+			column: ColumnInfo{
+				TableName:   "test_column_types_dbasserts",
+				Name:        "name",
+				Type:        "text",
+				IsNullable:  true,
+				IsGenerated: true,
+			},
+			want: false,
+		},
+		{
+			name: "bad generation expression",
+			column: ColumnInfo{
+				TableName:            "test_column_types_dbasserts",
+				Name:                 "name_length",
+				Type:                 "integer",
+				IsNullable:           true,
+				GenerationExpression: "octet_length(name)",
+			},
+			want: false,
+		},
+		{
+			name: "invalid generation expression",
+			column: ColumnInfo{
+				TableName:            "test_column_types_dbasserts",
+				Name:                 "name_length",
+				Type:                 "integer",
+				IsNullable:           true,
+				GenerationExpression: "length(bad_column)",
+			},
+			want: false,
+		},
+		{
+			name: "default without cast",
+			column: ColumnInfo{
+				TableName:  "test_column_types_dbasserts",
+				Name:       "status",
+				Default:    "'new'",
+				Type:       "text",
+				IsNullable: true,
+			},
+			want: true,
+		},
+		{
+			name: "default with cast",
+			column: ColumnInfo{
+				TableName:  "test_column_types_dbasserts",
+				Name:       "status",
+				Default:    "'new'::text",
+				Type:       "text",
+				IsNullable: true,
+			},
+			want: true,
+		},
+		{
+			name: "bad default",
+			column: ColumnInfo{
+				TableName:  "test_column_types_dbasserts",
+				Name:       "status",
+				Default:    "'old'",
+				Type:       "text",
+				IsNullable: true,
+			},
+			want: false,
+		},
+		{
+			name: "equivalent default function",
+			column: ColumnInfo{
+				TableName:  "test_column_types_dbasserts",
+				Name:       "update_time",
+				Default:    "now()",
+				Type:       "timestamp with time zone",
+				IsNullable: true,
+			},
+			want: true,
+		},
+		{
+			name: "serial default",
+			column: ColumnInfo{
+				TableName: "test_serial_dbasserts",
+				Name:      "id",
+				Default:   "nextval('public.test_serial_dbasserts_id_seq')",
+				Type:      "integer",
+			},
+			want: true,
+		},
+		{
+			name: "default not evaluated",
+			column: ColumnInfo{
+				TableName:  "test_column_types_dbasserts",
+				Name:       "quantity",
+				Default:    "1 + 1",
+				Type:       "integer",
+				IsNullable: true,
+			},
+			want: false,
+		},
+		{
+			name: "evaluated default",
+			column: ColumnInfo{
+				TableName:  "test_column_types_dbasserts",
+				Name:       "quantity",
+				Default:    "1 + 1",
+				Type:       "integer",
+				IsNullable: true,
+			},
+			evaluateDefaults: true,
+			want:             true,
+		},
+		{
+			name: "volatile evaluated default",
+			column: ColumnInfo{
+				TableName: "test_serial_dbasserts",
+				Name:      "id",
+				Default:   "nextval('test_serial_dbasserts_id_seq') + 0",
+				Type:      "integer",
+			},
+			evaluateDefaults: true,
+			want:             false,
+		},
+		{
+			name: "bad evaluated default",
+			column: ColumnInfo{
+				TableName:  "test_column_types_dbasserts",
+				Name:       "quantity",
+				Default:    "1 + 2",
+				Type:       "integer",
+				IsNullable: true,
+			},
+			evaluateDefaults: true,
+			want:             false,
+		},
+		{
+			name: "invalid default",
+			column: ColumnInfo{
+				TableName:  "test_column_types_dbasserts",
+				Name:       "quantity",
+				Default:    "bad_function()",
+				Type:       "integer",
+				IsNullable: true,
+			},
+			want: false,
+		},
 		{
 			name: "bad type",
 			column: ColumnInfo{
@@ -88,6 +473,7 @@ func TestDbAsserts_Column(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockery := new(MockTesting)
 			a := New(mockery, conn, "postgres")
+			a.EvaluateDefaults = tt.evaluateDefaults
 
 			if got := a.Column(tt.column); got != tt.want {
 				t.Errorf("Column() = %v, want %v", got, tt.want)
@@ -179,6 +565,7 @@ func TestDbAsserts_Nullable(t *testing.T) {
 	}()
 	cases := []struct {
 		name      string
+		schema    string
 		tableName string
 		colName   string
 		want      bool
@@ -189,6 +576,44 @@ func TestDbAsserts_Nullable(t *testing.T) {
 			colName:   "nullable",
 			want:      true,
 		},
+		{
+			name:      "qualified",
+			tableName: "public.test_table_dbasserts",
+			colName:   "nullable",
+			want:      true,
+		},
+		{
+			name:      "ambiguous",
+			tableName: "test_multi_schema_dbasserts",
+			colName:   "name",
+			want:      false,
+		},
+		{
+			name:      "qualified-public",
+			tableName: "public.test_multi_schema_dbasserts",
+			colName:   "name",
+			want:      true,
+		},
+		{
+			name:      "qualified-audit",
+			tableName: "dbasserts_audit.test_multi_schema_dbasserts",
+			colName:   "name",
+			want:      false,
+		},
+		{
+			name:      "default-schema",
+			schema:    "public",
+			tableName: "test_multi_schema_dbasserts",
+			colName:   "name",
+			want:      true,
+		},
+		{
+			name:      "default-schema-audit",
+			schema:    "dbasserts_audit",
+			tableName: "test_multi_schema_dbasserts",
+			colName:   "name",
+			want:      false,
+		},
 		{
 			name:      "typeInt-bad-colname",
 			tableName: "test_table_dbasserts",
@@ -212,6 +637,7 @@ func TestDbAsserts_Nullable(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockery := new(MockTesting)
 			a := New(mockery, conn, "postgres")
+			a.Schema = tt.schema
 
 			if got := a.Nullable(tt.tableName, tt.colName); got != tt.want {
 				t.Errorf("Nullable() = %v, want %v", got, tt.want)
@@ -225,3 +651,249 @@ func TestDbAsserts_Nullable(t *testing.T) {
 		})
 	}
 }
+
+func TestDbAsserts_NotNullable(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	cases := []struct {
+		name      string
+		tableName string
+		colName   string
+		want      bool
+	}{
+		{
+			name:      "public_id",
+			tableName: "test_table_dbasserts",
+			colName:   "public_id",
+			want:      true,
+		},
+		{
+			name:      "qualified-audit",
+			tableName: "dbasserts_audit.test_multi_schema_dbasserts",
+			colName:   "name",
+			want:      true,
+		},
+		{
+			name:      "nullable",
+			tableName: "test_table_dbasserts",
+			colName:   "nullable",
+			want:      false,
+		},
+		{
+			name:      "bad_column",
+			tableName: "test_table_dbasserts",
+			colName:   "bad_column",
+			want:      false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := a.NotNullable(tt.tableName, tt.colName); got != tt.want {
+				t.Errorf("NotNullable() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
+
+func TestDbAsserts_NotDomain(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	cases := []struct {
+		name      string
+		tableName string
+		colName   string
+		domain    string
+		want      bool
+	}{
+		{
+			name:      "nullable",
+			tableName: "test_table_dbasserts",
+			colName:   "nullable",
+			domain:    "dbasserts_public_id",
+			want:      true,
+		},
+		{
+			name:      "public_id",
+			tableName: "test_table_dbasserts",
+			colName:   "public_id",
+			domain:    "dbasserts_public_id",
+			want:      false,
+		},
+		{
+			name:      "bad_column",
+			tableName: "test_table_dbasserts",
+			colName:   "bad_column",
+			domain:    "dbasserts_public_id",
+			want:      false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := a.NotDomain(tt.tableName, tt.colName, tt.domain); got != tt.want {
+				t.Errorf("NotDomain() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
+
+func TestDbAsserts_TypeIs(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	cases := []struct {
+		name      string
+		tableName string
+		colName   string
+		typeName  string
+		want      bool
+	}{
+		{
+			name:      "int",
+			tableName: "test_table_dbasserts",
+			colName:   "type_int",
+			typeName:  "int",
+			want:      true,
+		},
+		{
+			name:      "integer",
+			tableName: "test_table_dbasserts",
+			colName:   "type_int",
+			typeName:  "integer",
+			want:      true,
+		},
+		{
+			name:      "domain",
+			tableName: "test_table_dbasserts",
+			colName:   "public_id",
+			typeName:  "dbasserts_public_id",
+			want:      true,
+		},
+		{
+			name:      "varchar",
+			tableName: "test_column_types_dbasserts",
+			colName:   "code",
+			typeName:  "varchar",
+			want:      true,
+		},
+		{
+			name:      "varchar-length",
+			tableName: "test_column_types_dbasserts",
+			colName:   "code",
+			typeName:  "character varying(32)",
+			want:      true,
+		},
+		{
+			name:      "bad-varchar-length",
+			tableName: "test_column_types_dbasserts",
+			colName:   "code",
+			typeName:  "character varying(64)",
+			want:      false,
+		},
+		{
+			name:      "varchar-alias-length",
+			tableName: "test_column_types_dbasserts",
+			colName:   "code",
+			typeName:  "varchar(32)",
+			want:      true,
+		},
+		{
+			name:      "numeric-precision-scale",
+			tableName: "test_column_types_dbasserts",
+			colName:   "amount",
+			typeName:  "numeric(10,2)",
+			want:      true,
+		},
+		{
+			name:      "bad-numeric-scale",
+			tableName: "test_column_types_dbasserts",
+			colName:   "amount",
+			typeName:  "numeric(10,4)",
+			want:      false,
+		},
+		{
+			name:      "timestamp-precision",
+			tableName: "test_column_types_dbasserts",
+			colName:   "create_time",
+			typeName:  "timestamp(3)",
+			want:      true,
+		},
+		{
+			name:      "array",
+			tableName: "test_column_types_dbasserts",
+			colName:   "tags",
+			typeName:  "text[]",
+			want:      true,
+		},
+		{
+			name:      "bad-type",
+			tableName: "test_table_dbasserts",
+			colName:   "type_int",
+			typeName:  "bigint",
+			want:      false,
+		},
+		{
+			name:      "bad_column",
+			tableName: "test_table_dbasserts",
+			colName:   "bad_column",
+			typeName:  "text",
+			want:      false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := a.TypeIs(tt.tableName, tt.colName, tt.typeName); got != tt.want {
+				t.Errorf("TypeIs() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TableComment asserts the comment on tableName is comment.
+func (a *DbAsserts) TableComment(tableName, comment string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, table, err := a.resolveTable(tableName)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	const query = `
+select coalesce(obj_description(c.oid, 'pg_class'), '')
+from pg_class c
+join pg_namespace n on n.oid = c.relnamespace
+where n.nspname = $1 and c.relname = $2`
+	return a.assertComment(fmt.Sprintf("table %s", tableName), comment, query, schema, table)
+}
+
+// ColumnComment asserts the comment on colName in tableName is comment.
+func (a *DbAsserts) ColumnComment(tableName, colName, comment string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, table, err := a.resolveTable(tableName)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	const query = `
+select coalesce(col_description(c.oid, a.attnum), '')
+from pg_attribute a
+join pg_class c on c.oid = a.attrelid
+join pg_namespace n on n.oid = c.relnamespace
+where n.nspname = $1
+	and c.relname = $2
+	and a.attname = $3
+	and a.attnum > 0
+	and not a.attisdropped`
+	return a.assertComment(fmt.Sprintf("column %s.%s", tableName, colName), comment, query, schema, table, colName)
+}
+
+// DomainComment asserts the comment on domainName is comment.
+func (a *DbAsserts) DomainComment(domainName, comment string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, domain, err := a.resolveName("domain", domainName, domainSchemasQuery)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	const query = `
+select coalesce(obj_description(t.oid, 'pg_type'), '')
+from pg_type t
+join pg_namespace n on n.oid = t.typnamespace
+where n.nspname = $1 and t.typname = $2 and t.typtype = 'd'`
+	return a.assertComment(fmt.Sprintf("domain %s", domainName), comment, query, schema, domain)
+}
+
+// FunctionComment asserts the comment on the function name with the
+// argument types args is comment.
+func (a *DbAsserts) FunctionComment(name, comment string, args ...string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	_, signature, err := a.functionSignature(name, args...)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	const query = `
+select coalesce(obj_description(p.oid, 'pg_proc'), '')
+from pg_proc p
+where p.oid = to_regprocedure($1)`
+	return a.assertComment(fmt.Sprintf("function %s", signature), comment, query, signature)
+}
+
+// Documented asserts tableName and every one of its columns have a
+// comment, reporting every one that doesn't.
+func (a *DbAsserts) Documented(tableName string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, table, err := a.resolveTable(tableName)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	const query = `
+select 'table ' || c.relname
+from pg_class c
+join pg_namespace n on n.oid = c.relnamespace
+where n.nspname = $1
+	and c.relname = $2
+	and coalesce(obj_description(c.oid, 'pg_class'), '') = ''
+union all
+select 'column ' || c.relname || '.' || a.attname
+from pg_attribute a
+join pg_class c on c.oid = a.attrelid
+join pg_namespace n on n.oid = c.relnamespace
+where n.nspname = $1
+	and c.relname = $2
+	and a.attnum > 0
+	and not a.attisdropped
+	and coalesce(col_description(c.oid, a.attnum), '') = ''`
+	undocumented, err := a.queryStrings(query, schema, table)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	if len(undocumented) == 0 {
+		return true
+	}
+	assert.Fail(a.T, "not documented", "%s: missing comments on %s", tableName, strings.Join(undocumented, ", "))
+	return false
+}
+
+func (a *DbAsserts) assertComment(object, comment, query string, args ...interface{}) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	var dbComment string
+	err := a.Db.QueryRow(query, args...).Scan(&dbComment)
+	switch {
+	case err == sql.ErrNoRows:
+		assert.FailNow(a.T, fmt.Sprintf("%s not found", object))
+		return false
+	case err != nil:
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	return assert.Equal(a.T, comment, dbComment, "%s: comment is not valid", object)
+}
@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"testing"
+)
+
+func TestDbAsserts_Comment(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	cases := []struct {
+		name   string
+		assert func(a *DbAsserts) bool
+		want   bool
+	}{
+		{
+			name: "table",
+			assert: func(a *DbAsserts) bool {
+				return a.TableComment("test_table_dbasserts", "dbasserts test table")
+			},
+			want: true,
+		},
+		{
+			name: "table-bad-comment",
+			assert: func(a *DbAsserts) bool {
+				return a.TableComment("test_table_dbasserts", "bad comment")
+			},
+			want: false,
+		},
+		{
+			name: "table-no-comment",
+			assert: func(a *DbAsserts) bool {
+				return a.TableComment("test_index_dbasserts", "dbasserts test table")
+			},
+			want: false,
+		},
+		{
+			name: "table-bad-table",
+			assert: func(a *DbAsserts) bool {
+				return a.TableComment("bad_table", "dbasserts test table")
+			},
+			want: false,
+		},
+		{
+			name: "column",
+			assert: func(a *DbAsserts) bool {
+				return a.ColumnComment("test_table_dbasserts", "public_id", "dbasserts test public id")
+			},
+			want: true,
+		},
+		{
+			name: "column-no-comment",
+			assert: func(a *DbAsserts) bool {
+				return a.ColumnComment("test_table_dbasserts", "nullable", "dbasserts test public id")
+			},
+			want: false,
+		},
+		{
+			name: "column-bad-column",
+			assert: func(a *DbAsserts) bool {
+				return a.ColumnComment("test_table_dbasserts", "bad_column", "dbasserts test public id")
+			},
+			want: false,
+		},
+		{
+			name: "domain",
+			assert: func(a *DbAsserts) bool {
+				return a.DomainComment("dbasserts_public_id", "dbasserts test domain type")
+			},
+			want: true,
+		},
+		{
+			name: "domain-bad-comment",
+			assert: func(a *DbAsserts) bool {
+				return a.DomainComment("dbasserts_public_id", "bad comment")
+			},
+			want: false,
+		},
+		{
+			name: "function",
+			assert: func(a *DbAsserts) bool {
+				return a.FunctionComment("test_add_dbasserts", "dbasserts test function", "int", "int")
+			},
+			want: true,
+		},
+		{
+			name: "function-bad-args",
+			assert: func(a *DbAsserts) bool {
+				return a.FunctionComment("test_add_dbasserts", "dbasserts test function")
+			},
+			want: false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := tt.assert(a); got != tt.want {
+				t.Errorf("Comment() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
+
+func TestDbAsserts_Documented(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	cases := []struct {
+		name      string
+		tableName string
+		want      bool
+	}{
+		{
+			name:      "documented",
+			tableName: "test_documented_dbasserts",
+			want:      true,
+		},
+		{
+			name:      "undocumented-columns",
+			tableName: "test_table_dbasserts",
+			want:      false,
+		},
+		{
+			name:      "undocumented",
+			tableName: "test_index_dbasserts",
+			want:      false,
+		},
+		{
+			name:      "bad-table",
+			tableName: "bad_table",
+			want:      false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := a.Documented(tt.tableName); got != tt.want {
+				t.Errorf("Documented() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
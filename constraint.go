@@ -0,0 +1,465 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+// ForeignKeyInfo defines a set of information about a foreign key.
+type ForeignKeyInfo struct {
+	// Schema of the foreign key's table. When empty, the schema is resolved
+	// from TableName or the DbAsserts Schema and isn't compared by
+	// ForeignKey.
+	Schema string
+
+	// TableName for the foreign key, optionally qualified with its schema
+	// (schema.table).
+	TableName string
+
+	// Name of the foreign key constraint. When empty, the foreign key is
+	// found using Columns and its name isn't compared by ForeignKey.
+	Name string
+
+	// Columns of the foreign key, in order.
+	Columns []string
+
+	// RefSchema of the referenced table. When empty, it isn't compared by
+	// ForeignKey.
+	RefSchema string
+
+	// RefTableName is the referenced table, optionally qualified with its
+	// schema (schema.table).
+	RefTableName string
+
+	// RefColumns are the referenced columns, in order.
+	RefColumns []string
+
+	// OnDelete is the referential action on delete: NO ACTION, RESTRICT,
+	// CASCADE, SET NULL or SET DEFAULT. Empty is NO ACTION.
+	OnDelete string
+
+	// OnUpdate is the referential action on update: NO ACTION, RESTRICT,
+	// CASCADE, SET NULL or SET DEFAULT. Empty is NO ACTION.
+	OnUpdate string
+
+	// MatchType of the foreign key: SIMPLE, FULL or PARTIAL. Empty is
+	// SIMPLE.
+	MatchType string
+
+	// IsDeferrable defines if the foreign key is deferrable.
+	IsDeferrable bool
+
+	// IsInitiallyDeferred defines if the foreign key is initially deferred.
+	IsInitiallyDeferred bool
+}
+
+var (
+	referentialActions = map[string]string{
+		"a": "NO ACTION",
+		"r": "RESTRICT",
+		"c": "CASCADE",
+		"n": "SET NULL",
+		"d": "SET DEFAULT",
+	}
+	matchTypes = map[string]string{
+		"s": "SIMPLE",
+		"f": "FULL",
+		"p": "PARTIAL",
+	}
+)
+
+// PrimaryKey asserts the primary key of tableName is made up of exactly cols,
+// in order.
+func (a *DbAsserts) PrimaryKey(tableName string, cols ...string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	pkCols, err := a.getPrimaryKey(tableName)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	if len(pkCols) == 0 {
+		assert.Fail(a.T, "missing primary key", "%s: has no primary key", tableName)
+		return false
+	}
+	return assert.Equal(a.T, cols, pkCols, "%s: primary key columns are not valid", tableName)
+}
+
+func (a *DbAsserts) getPrimaryKey(tableName string) ([]string, error) {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, table, err := a.resolveTable(tableName)
+	if err != nil {
+		return nil, err
+	}
+	const query = `
+select a.attname
+from pg_constraint con
+join pg_class c on c.oid = con.conrelid
+join pg_namespace n on n.oid = c.relnamespace
+cross join lateral unnest(con.conkey) with ordinality as k(attnum, ord)
+join pg_attribute a on a.attrelid = con.conrelid and a.attnum = k.attnum
+where n.nspname = $1 and c.relname = $2 and con.contype = 'p'
+order by k.ord`
+	return a.queryStrings(query, schema, table)
+}
+
+// ForeignKey asserts fk ForeignKeyInfo is valid. The foreign key is found by
+// its Name or, when Name is empty, by its Columns.
+func (a *DbAsserts) ForeignKey(fk ForeignKeyInfo) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	dbForeignKeys, err := a.getForeignKeys(qualifyName(fk.Schema, fk.TableName))
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	var dbForeignKey *ForeignKeyInfo
+	for _, k := range dbForeignKeys {
+		if (fk.Name != "" && k.Name == fk.Name) ||
+			(fk.Name == "" && strings.Join(k.Columns, ",") == strings.Join(fk.Columns, ",")) {
+			dbForeignKey = k
+			break
+		}
+	}
+	if dbForeignKey == nil {
+		assert.Fail(a.T, "foreign key not found", "%s: foreign key %s%v not found", fk.TableName, fk.Name, fk.Columns)
+		return false
+	}
+	want := fk
+	if schema, name := splitName(want.RefTableName); schema != "" {
+		want.RefSchema, want.RefTableName = schema, name
+	}
+	want.OnDelete = defaultString(strings.ToUpper(want.OnDelete), "NO ACTION")
+	want.OnUpdate = defaultString(strings.ToUpper(want.OnUpdate), "NO ACTION")
+	want.MatchType = defaultString(strings.ToUpper(want.MatchType), "SIMPLE")
+	dbForeignKey.TableName = fk.TableName
+	if fk.Schema == "" {
+		dbForeignKey.Schema = ""
+	}
+	if fk.Name == "" {
+		dbForeignKey.Name = ""
+	}
+	if want.RefSchema == "" {
+		dbForeignKey.RefSchema = ""
+	}
+	return assert.Equal(a.T, want, *dbForeignKey, "%s: foreign key %s%v is not valid", fk.TableName, fk.Name, fk.Columns)
+}
+
+func (a *DbAsserts) getForeignKeys(tableName string) ([]*ForeignKeyInfo, error) {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, table, err := a.resolveTable(tableName)
+	if err != nil {
+		return nil, err
+	}
+	const query = `
+select
+	con.conname,
+	array(
+		select a.attname::text
+		from unnest(con.conkey) with ordinality as k(attnum, ord)
+		join pg_attribute a on a.attrelid = con.conrelid and a.attnum = k.attnum
+		order by k.ord
+	),
+	rn.nspname,
+	rc.relname,
+	array(
+		select a.attname::text
+		from unnest(con.confkey) with ordinality as k(attnum, ord)
+		join pg_attribute a on a.attrelid = con.confrelid and a.attnum = k.attnum
+		order by k.ord
+	),
+	con.confdeltype,
+	con.confupdtype,
+	con.confmatchtype,
+	con.condeferrable,
+	con.condeferred
+from pg_constraint con
+join pg_class c on c.oid = con.conrelid
+join pg_namespace n on n.oid = c.relnamespace
+join pg_class rc on rc.oid = con.confrelid
+join pg_namespace rn on rn.oid = rc.relnamespace
+where n.nspname = $1 and c.relname = $2 and con.contype = 'f'
+order by con.conname`
+	rows, err := a.Db.Query(query, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var foreignKeys []*ForeignKeyInfo
+	for rows.Next() {
+		fk := ForeignKeyInfo{
+			Schema:    schema,
+			TableName: tableName,
+		}
+		var onDelete, onUpdate, matchType string
+		if err := rows.Scan(
+			&fk.Name,
+			pq.Array(&fk.Columns),
+			&fk.RefSchema,
+			&fk.RefTableName,
+			pq.Array(&fk.RefColumns),
+			&onDelete,
+			&onUpdate,
+			&matchType,
+			&fk.IsDeferrable,
+			&fk.IsInitiallyDeferred,
+		); err != nil {
+			return nil, err
+		}
+		fk.OnDelete = referentialActions[onDelete]
+		fk.OnUpdate = referentialActions[onUpdate]
+		fk.MatchType = matchTypes[matchType]
+		foreignKeys = append(foreignKeys, &fk)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return foreignKeys, nil
+}
+
+// uniqueInfo defines a unique constraint or unique index.
+type uniqueInfo struct {
+	name             string
+	isConstraint     bool
+	nullsNotDistinct bool
+	columns          []string
+}
+
+func (u uniqueInfo) String() string {
+	kind := "unique index"
+	if u.isConstraint {
+		kind = "unique constraint"
+	}
+	s := fmt.Sprintf("%s %s (%s)", kind, u.name, strings.Join(u.columns, ", "))
+	if u.nullsNotDistinct {
+		s += " nulls not distinct"
+	}
+	return s
+}
+
+// Unique asserts a unique constraint or a unique index on tableName covers
+// exactly cols, in any order. Partial and expression indexes are ignored.
+// When a.T is a TLogger, the matching constraint or index is logged.
+func (a *DbAsserts) Unique(tableName string, cols ...string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	dbUniques, err := a.getUniques(tableName)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	want := sortedStrings(cols)
+	var found []string
+	for _, u := range dbUniques {
+		if strings.Join(sortedStrings(u.columns), ",") == strings.Join(want, ",") {
+			if l, ok := a.T.(TLogger); ok {
+				l.Logf("%s: %s", tableName, u)
+			}
+			return true
+		}
+		found = append(found, u.String())
+	}
+	assert.Fail(a.T, "not unique", "%s: no unique constraint or index on (%s), found: %v", tableName, strings.Join(cols, ", "), found)
+	return false
+}
+
+func (a *DbAsserts) getUniques(tableName string) ([]uniqueInfo, error) {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, table, err := a.resolveTable(tableName)
+	if err != nil {
+		return nil, err
+	}
+	// indnullsnotdistinct is read from the row as json since it only exists
+	// on postgres 15 and later.
+	const query = `
+select
+	i.relname,
+	con.conname is not null,
+	coalesce((to_jsonb(ix) ->> 'indnullsnotdistinct')::boolean, false),
+	array(
+		select a.attname::text
+		from unnest(ix.indkey) with ordinality as k(attnum, ord)
+		join pg_attribute a on a.attrelid = ix.indrelid and a.attnum = k.attnum
+		where k.ord <= ix.indnkeyatts
+		order by k.ord
+	)
+from pg_index ix
+join pg_class i on i.oid = ix.indexrelid
+join pg_class c on c.oid = ix.indrelid
+join pg_namespace n on n.oid = c.relnamespace
+left join pg_constraint con on con.conindid = ix.indexrelid and con.contype = 'u'
+where n.nspname = $1 and c.relname = $2
+	and ix.indisunique
+	and not ix.indisprimary
+	and ix.indpred is null
+	and ix.indexprs is null
+order by i.relname`
+	rows, err := a.Db.Query(query, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var uniques []uniqueInfo
+	for rows.Next() {
+		var u uniqueInfo
+		if err := rows.Scan(&u.name, &u.isConstraint, &u.nullsNotDistinct, pq.Array(&u.columns)); err != nil {
+			return nil, err
+		}
+		uniques = append(uniques, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return uniques, nil
+}
+
+// sortedStrings returns a sorted copy of values.
+func sortedStrings(values []string) []string {
+	sorted := append([]string(nil), values...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+// checkOwnerSchemasQuery finds the schemas containing a table or domain named
+// $1.
+const checkOwnerSchemasQuery = `
+select n.nspname
+from pg_class c
+join pg_namespace n on n.oid = c.relnamespace
+where c.relname = $1
+	and c.relkind in ('r', 'p', 'f')
+	and n.nspname not in ('pg_catalog', 'information_schema')
+union
+select n.nspname
+from pg_type t
+join pg_namespace n on n.oid = t.typnamespace
+where t.typname = $1
+	and t.typtype = 'd'
+	and n.nspname not in ('pg_catalog', 'information_schema')
+order by 1`
+
+// checkScratchName names the scratch objects used to normalize check
+// definitions.
+const checkScratchName = "dbassert_check"
+
+var (
+	checkKeyword  = regexp.MustCompile(`(?i)^\s*check\s*\(`)
+	checkNotValid = regexp.MustCompile(`(?i)\s+NOT VALID$`)
+)
+
+// checkInfo defines a check constraint on a table or domain.
+type checkInfo struct {
+	schema     string
+	owner      string
+	isDomain   bool
+	baseType   string
+	definition string
+}
+
+// Check asserts the check constraint constraintName on name, a table or a
+// domain, has definition. The definition may omit the CHECK keyword and is
+// compared after being normalized by the database, so it doesn't need to
+// match the text returned by pg_get_constraintdef.
+func (a *DbAsserts) Check(name, constraintName, definition string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	dbCheck, err := a.getCheck(name, constraintName)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	want, err := a.normalizeCheck(dbCheck, definition)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	return assert.Equal(a.T, want, dbCheck.definition, "%s: check constraint %s is not valid", name, constraintName)
+}
+
+func (a *DbAsserts) getCheck(name, constraintName string) (*checkInfo, error) {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, owner, err := a.resolveName("table or domain", name, checkOwnerSchemasQuery)
+	if err != nil {
+		return nil, err
+	}
+	const query = `
+select
+	pg_get_constraintdef(con.oid),
+	con.contypid <> 0,
+	coalesce(format_type(t.typbasetype, t.typtypmod), '')
+from pg_constraint con
+left join pg_class c on c.oid = con.conrelid
+left join pg_type t on t.oid = con.contypid
+join pg_namespace n on n.oid = coalesce(c.relnamespace, t.typnamespace)
+where con.contype = 'c'
+	and n.nspname = $1
+	and coalesce(c.relname, t.typname) = $2
+	and con.conname = $3`
+	check := checkInfo{
+		schema: schema,
+		owner:  owner,
+	}
+	err = a.Db.QueryRow(query, schema, owner, constraintName).Scan(&check.definition, &check.isDomain, &check.baseType)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, fmt.Errorf("%s: check constraint %s not found", name, constraintName)
+	case err != nil:
+		return nil, err
+	}
+	check.definition = checkNotValid.ReplaceAllString(check.definition, "")
+	return &check, nil
+}
+
+// normalizeCheck has the database render definition by adding it as a check
+// constraint to a scratch copy of the check's table or domain.
+func (a *DbAsserts) normalizeCheck(check *checkInfo, definition string) (string, error) {
+	// only the keyword is removed, so its parenthesized condition is kept
+	// together and a column named like checksum isn't truncated.
+	definition = checkKeyword.ReplaceAllString(definition, "(")
+	var create, query string
+	switch {
+	case check.isDomain:
+		scratch := pq.QuoteIdentifier(check.schema) + "." + pq.QuoteIdentifier(checkScratchName)
+		create = fmt.Sprintf("create domain %s as %s constraint %s check (%s)",
+			scratch, check.baseType, checkScratchName, definition)
+		query = fmt.Sprintf("select pg_get_constraintdef(oid) from pg_constraint where contypid = %s::regtype and contype = 'c' and conname = '%s'",
+			pq.QuoteLiteral(scratch), checkScratchName)
+	default:
+		create = fmt.Sprintf("create temp table %s (like %s.%s); alter table %s add constraint %s check (%s)",
+			checkScratchName, pq.QuoteIdentifier(check.schema), pq.QuoteIdentifier(check.owner),
+			checkScratchName, checkScratchName, definition)
+		// like copies not null constraints, which postgres 18 and later
+		// store in pg_constraint too.
+		query = fmt.Sprintf("select pg_get_constraintdef(oid) from pg_constraint where conrelid = 'pg_temp.%[1]s'::regclass and contype = 'c' and conname = '%[1]s'",
+			checkScratchName)
+	}
+	var normalized string
+	err := a.withRollback(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(create); err != nil {
+			return fmt.Errorf("invalid check definition %q: %w", definition, err)
+		}
+		return tx.QueryRow(query).Scan(&normalized)
+	})
+	return normalized, err
+}
@@ -0,0 +1,485 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"testing"
+)
+
+func TestDbAsserts_PrimaryKey(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	cases := []struct {
+		name      string
+		tableName string
+		cols      []string
+		want      bool
+	}{
+		{
+			name:      "single",
+			tableName: "test_table_dbasserts",
+			cols:      []string{"id"},
+			want:      true,
+		},
+		{
+			name:      "composite",
+			tableName: "test_parent_dbasserts",
+			cols:      []string{"tenant_id", "id"},
+			want:      true,
+		},
+		{
+			name:      "composite-wrong-order",
+			tableName: "test_parent_dbasserts",
+			cols:      []string{"id", "tenant_id"},
+			want:      false,
+		},
+		{
+			name:      "composite-missing-col",
+			tableName: "test_parent_dbasserts",
+			cols:      []string{"tenant_id"},
+			want:      false,
+		},
+		{
+			name:      "wrong-col",
+			tableName: "test_table_dbasserts",
+			cols:      []string{"public_id"},
+			want:      false,
+		},
+		{
+			name:      "no-pk",
+			tableName: "test_no_pk_dbasserts",
+			cols:      []string{"name"},
+			want:      false,
+		},
+		{
+			name:      "bad_table",
+			tableName: "bad_table",
+			cols:      []string{"id"},
+			want:      false,
+		},
+		{
+			name:      "qualified-bad-table",
+			tableName: "public.bad_table",
+			cols:      []string{"id"},
+			want:      false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := a.PrimaryKey(tt.tableName, tt.cols...); got != tt.want {
+				t.Errorf("PrimaryKey() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
+
+func TestDbAsserts_ForeignKey(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	cases := []struct {
+		name string
+		fk   ForeignKeyInfo
+		want bool
+	}{
+		{
+			name: "by-name",
+			fk: ForeignKeyInfo{
+				TableName:           "test_child_dbasserts",
+				Name:                "test_child_parent_fkey",
+				Columns:             []string{"tenant_id", "parent_id"},
+				RefTableName:        "test_parent_dbasserts",
+				RefColumns:          []string{"tenant_id", "id"},
+				OnDelete:            "CASCADE",
+				OnUpdate:            "RESTRICT",
+				MatchType:           "FULL",
+				IsDeferrable:        true,
+				IsInitiallyDeferred: true,
+			},
+			want: true,
+		},
+		{
+			name: "by-columns",
+			fk: ForeignKeyInfo{
+				TableName:           "test_child_dbasserts",
+				Columns:             []string{"tenant_id", "parent_id"},
+				RefTableName:        "test_parent_dbasserts",
+				RefColumns:          []string{"tenant_id", "id"},
+				OnDelete:            "CASCADE",
+				OnUpdate:            "RESTRICT",
+				MatchType:           "FULL",
+				IsDeferrable:        true,
+				IsInitiallyDeferred: true,
+			},
+			want: true,
+		},
+		{
+			name: "qualified",
+			fk: ForeignKeyInfo{
+				Schema:              "public",
+				TableName:           "test_child_dbasserts",
+				Name:                "test_child_parent_fkey",
+				Columns:             []string{"tenant_id", "parent_id"},
+				RefTableName:        "public.test_parent_dbasserts",
+				RefColumns:          []string{"tenant_id", "id"},
+				OnDelete:            "CASCADE",
+				OnUpdate:            "RESTRICT",
+				MatchType:           "FULL",
+				IsDeferrable:        true,
+				IsInitiallyDeferred: true,
+			},
+			want: true,
+		},
+		{
+			name: "defaults",
+			fk: ForeignKeyInfo{
+				TableName:    "test_child_dbasserts",
+				Columns:      []string{"owner_id"},
+				RefTableName: "test_table_dbasserts",
+				RefColumns:   []string{"id"},
+			},
+			want: true,
+		},
+		{
+			name: "missing-cascade",
+			fk: ForeignKeyInfo{
+				TableName:           "test_child_dbasserts",
+				Name:                "test_child_parent_fkey",
+				Columns:             []string{"tenant_id", "parent_id"},
+				RefTableName:        "test_parent_dbasserts",
+				RefColumns:          []string{"tenant_id", "id"},
+				OnUpdate:            "RESTRICT",
+				MatchType:           "FULL",
+				IsDeferrable:        true,
+				IsInitiallyDeferred: true,
+			},
+			want: false,
+		},
+		{
+			name: "bad-on-update",
+			fk: ForeignKeyInfo{
+				TableName:           "test_child_dbasserts",
+				Name:                "test_child_parent_fkey",
+				Columns:             []string{"tenant_id", "parent_id"},
+				RefTableName:        "test_parent_dbasserts",
+				RefColumns:          []string{"tenant_id", "id"},
+				OnDelete:            "CASCADE",
+				OnUpdate:            "cascade",
+				MatchType:           "FULL",
+				IsDeferrable:        true,
+				IsInitiallyDeferred: true,
+			},
+			want: false,
+		},
+		{
+			name: "bad-match-type",
+			fk: ForeignKeyInfo{
+				TableName:           "test_child_dbasserts",
+				Name:                "test_child_parent_fkey",
+				Columns:             []string{"tenant_id", "parent_id"},
+				RefTableName:        "test_parent_dbasserts",
+				RefColumns:          []string{"tenant_id", "id"},
+				OnDelete:            "CASCADE",
+				OnUpdate:            "RESTRICT",
+				IsDeferrable:        true,
+				IsInitiallyDeferred: true,
+			},
+			want: false,
+		},
+		{
+			name: "not-deferred",
+			fk: ForeignKeyInfo{
+				TableName:    "test_child_dbasserts",
+				Name:         "test_child_parent_fkey",
+				Columns:      []string{"tenant_id", "parent_id"},
+				RefTableName: "test_parent_dbasserts",
+				RefColumns:   []string{"tenant_id", "id"},
+				OnDelete:     "CASCADE",
+				OnUpdate:     "RESTRICT",
+				MatchType:    "FULL",
+				IsDeferrable: true,
+			},
+			want: false,
+		},
+		{
+			name: "bad-ref-columns",
+			fk: ForeignKeyInfo{
+				TableName:           "test_child_dbasserts",
+				Name:                "test_child_parent_fkey",
+				Columns:             []string{"tenant_id", "parent_id"},
+				RefTableName:        "test_parent_dbasserts",
+				RefColumns:          []string{"id", "tenant_id"},
+				OnDelete:            "CASCADE",
+				OnUpdate:            "RESTRICT",
+				MatchType:           "FULL",
+				IsDeferrable:        true,
+				IsInitiallyDeferred: true,
+			},
+			want: false,
+		},
+		{
+			name: "bad-columns",
+			fk: ForeignKeyInfo{
+				TableName:           "test_child_dbasserts",
+				Columns:             []string{"parent_id"},
+				RefTableName:        "test_parent_dbasserts",
+				RefColumns:          []string{"tenant_id", "id"},
+				OnDelete:            "CASCADE",
+				OnUpdate:            "RESTRICT",
+				MatchType:           "FULL",
+				IsDeferrable:        true,
+				IsInitiallyDeferred: true,
+			},
+			want: false,
+		},
+		{
+			name: "bad-table",
+			fk: ForeignKeyInfo{
+				TableName:           "bad_table",
+				Name:                "test_child_parent_fkey",
+				Columns:             []string{"tenant_id", "parent_id"},
+				RefTableName:        "test_parent_dbasserts",
+				RefColumns:          []string{"tenant_id", "id"},
+				OnDelete:            "CASCADE",
+				OnUpdate:            "RESTRICT",
+				MatchType:           "FULL",
+				IsDeferrable:        true,
+				IsInitiallyDeferred: true,
+			},
+			want: false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := a.ForeignKey(tt.fk); got != tt.want {
+				t.Errorf("ForeignKey() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
+
+func TestDbAsserts_Unique(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	cases := []struct {
+		name      string
+		tableName string
+		cols      []string
+		want      bool
+		wantLog   string
+	}{
+		{
+			name:      "constraint",
+			tableName: "test_unique_dbasserts",
+			cols:      []string{"tenant_id", "name"},
+			want:      true,
+			wantLog:   "test_unique_dbasserts: unique constraint test_unique_tenant_name_uq (tenant_id, name)",
+		},
+		{
+			name:      "constraint-any-order",
+			tableName: "test_unique_dbasserts",
+			cols:      []string{"name", "tenant_id"},
+			want:      true,
+			wantLog:   "test_unique_dbasserts: unique constraint test_unique_tenant_name_uq (tenant_id, name)",
+		},
+		{
+			name:      "index-nulls-not-distinct",
+			tableName: "test_unique_dbasserts",
+			cols:      []string{"email"},
+			want:      true,
+			wantLog:   "test_unique_dbasserts: unique index test_unique_email_idx (email) nulls not distinct",
+		},
+		{
+			name:      "partial-index",
+			tableName: "test_unique_dbasserts",
+			cols:      []string{"code"},
+			want:      false,
+		},
+		{
+			name:      "subset",
+			tableName: "test_unique_dbasserts",
+			cols:      []string{"name"},
+			want:      false,
+		},
+		{
+			name:      "primary-key",
+			tableName: "test_unique_dbasserts",
+			cols:      []string{"id"},
+			want:      false,
+		},
+		{
+			name:      "bad_table",
+			tableName: "bad_table",
+			cols:      []string{"name"},
+			want:      false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := a.Unique(tt.tableName, tt.cols...); got != tt.want {
+				t.Errorf("Unique() = %v, want %v", got, tt.want)
+			}
+			if got := mockery.LogMsg(); got != tt.wantLog {
+				t.Errorf("Unique() logged %q, want %q", got, tt.wantLog)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
+
+func TestDbAsserts_Check(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	cases := []struct {
+		name           string
+		objName        string
+		constraintName string
+		definition     string
+		want           bool
+	}{
+		{
+			name:           "table",
+			objName:        "test_check_dbasserts",
+			constraintName: "test_check_amount_positive",
+			definition:     "amount > 0",
+			want:           true,
+		},
+		{
+			name:           "table-check-keyword",
+			objName:        "public.test_check_dbasserts",
+			constraintName: "test_check_amount_positive",
+			definition:     "CHECK (amount > 0)",
+			want:           true,
+		},
+		{
+			name:           "table-check-prefixed-column",
+			objName:        "test_check_dbasserts",
+			constraintName: "test_check_checksum_not_empty",
+			definition:     "checksum <> ''",
+			want:           true,
+		},
+		{
+			name:           "table-check-keyword-prefixed-column",
+			objName:        "test_check_dbasserts",
+			constraintName: "test_check_checksum_not_empty",
+			definition:     "check (checksum <> '')",
+			want:           true,
+		},
+		{
+			name:           "table-bad-definition",
+			objName:        "test_check_dbasserts",
+			constraintName: "test_check_amount_positive",
+			definition:     "amount >= 0",
+			want:           false,
+		},
+		{
+			name:           "table-invalid-definition",
+			objName:        "test_check_dbasserts",
+			constraintName: "test_check_amount_positive",
+			definition:     "bad_column > 0",
+			want:           false,
+		},
+		{
+			name:           "domain",
+			objName:        "dbasserts_public_id",
+			constraintName: "dbasserts_public_id_check",
+			definition:     "length(trim(value)) > 10",
+			want:           true,
+		},
+		{
+			name:           "domain-bad-definition",
+			objName:        "dbasserts_public_id",
+			constraintName: "dbasserts_public_id_check",
+			definition:     "length(value) > 10",
+			want:           false,
+		},
+		{
+			name:           "bad-constraint",
+			objName:        "test_check_dbasserts",
+			constraintName: "bad_constraint",
+			definition:     "amount > 0",
+			want:           false,
+		},
+		{
+			name:           "bad-table",
+			objName:        "bad_table",
+			constraintName: "test_check_amount_positive",
+			definition:     "amount > 0",
+			want:           false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := a.Check(tt.objName, tt.constraintName, tt.definition); got != tt.want {
+				t.Errorf("Check() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+// DomainInfo defines a set of information about a domain.
+type DomainInfo struct {
+	// Schema of the domain. When empty, the schema is resolved from Name or
+	// the DbAsserts Schema and isn't compared by DomainType.
+	Schema string
+
+	// Name of the domain, optionally qualified with its schema
+	// (schema.domain).
+	Name string
+
+	// BaseType of the domain as returned by format_type, for example text
+	// or character varying(32). It's normalized by the database, so
+	// varchar(32) matches character varying(32).
+	BaseType string
+
+	// IsNotNull defines if the domain is NOT NULL.
+	IsNotNull bool
+
+	// Default expression of the domain. It's compared after being
+	// normalized by the database, so it doesn't need to match the text
+	// stored in pg_type, for example 'none' matches
+	// 'none'::character varying.
+	Default string
+
+	// Collation of the domain when it differs from its base type's
+	// collation.
+	Collation string
+
+	// Checks are the definitions of every check constraint of the domain,
+	// by constraint name. Definitions may omit the CHECK keyword and are
+	// compared after being normalized by the database.
+	Checks map[string]string
+}
+
+// domainSchemasQuery finds the schemas containing a domain named $1.
+const domainSchemasQuery = `
+select n.nspname
+from pg_type t
+join pg_namespace n on n.oid = t.typnamespace
+where t.typname = $1
+	and t.typtype = 'd'
+	and n.nspname not in ('pg_catalog', 'information_schema')
+order by n.nspname`
+
+// domainScratchName names the scratch domain used to normalize defaults.
+const domainScratchName = "dbassert_domain"
+
+// DomainType asserts d DomainInfo is valid.
+func (a *DbAsserts) DomainType(d DomainInfo) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	dbDomain, err := a.getDomain(qualifyName(d.Schema, d.Name))
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	want := d
+	if want.BaseType, _, err = a.normalizeColumnType(want.BaseType); err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	if want.Default != "" {
+		if want.Default, err = a.normalizeDomainDefault(dbDomain, want.Default); err != nil {
+			assert.FailNow(a.T, err.Error())
+			return false
+		}
+	}
+	if len(want.Checks) > 0 {
+		check := &checkInfo{
+			schema:   dbDomain.Schema,
+			owner:    dbDomain.Name,
+			isDomain: true,
+			baseType: dbDomain.BaseType,
+		}
+		want.Checks = make(map[string]string, len(d.Checks))
+		for name, definition := range d.Checks {
+			if want.Checks[name], err = a.normalizeCheck(check, definition); err != nil {
+				assert.FailNow(a.T, err.Error())
+				return false
+			}
+		}
+	} else {
+		want.Checks = nil
+	}
+	dbDomain.Name = d.Name
+	if d.Schema == "" {
+		dbDomain.Schema = ""
+	}
+	return assert.Equal(a.T, want, *dbDomain, "%s: domain is not valid", d.Name)
+}
+
+func (a *DbAsserts) getDomain(name string) (*DomainInfo, error) {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, domainName, err := a.resolveName("domain", name, domainSchemasQuery)
+	if err != nil {
+		return nil, err
+	}
+	const query = `
+select
+	t.typtype,
+	format_type(t.typbasetype, t.typtypmod),
+	t.typnotnull,
+	coalesce(t.typdefault, ''),
+	coalesce(case when t.typcollation <> bt.typcollation then co.collname end, '')
+from pg_type t
+join pg_namespace n on n.oid = t.typnamespace
+left join pg_type bt on bt.oid = t.typbasetype
+left join pg_collation co on co.oid = t.typcollation
+where n.nspname = $1 and t.typname = $2`
+	d := DomainInfo{
+		Schema: schema,
+		Name:   domainName,
+	}
+	var typtype string
+	err = a.Db.QueryRow(query, schema, domainName).Scan(&typtype, &d.BaseType, &d.IsNotNull, &d.Default, &d.Collation)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, fmt.Errorf("domain %s not found", name)
+	case err != nil:
+		return nil, err
+	case typtype != "d":
+		return nil, fmt.Errorf("%s is not a domain", name)
+	}
+	const checksQuery = `
+select con.conname, pg_get_constraintdef(con.oid)
+from pg_constraint con
+join pg_type t on t.oid = con.contypid
+join pg_namespace n on n.oid = t.typnamespace
+where n.nspname = $1 and t.typname = $2 and con.contype = 'c'`
+	rows, err := a.Db.Query(checksQuery, schema, domainName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var conName, definition string
+		if err := rows.Scan(&conName, &definition); err != nil {
+			return nil, err
+		}
+		if d.Checks == nil {
+			d.Checks = map[string]string{}
+		}
+		d.Checks[conName] = checkNotValid.ReplaceAllString(definition, "")
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// normalizeDomainDefault has the database render expr by creating a scratch
+// domain, with d's schema and base type, which defaults to expr.
+func (a *DbAsserts) normalizeDomainDefault(d *DomainInfo, expr string) (string, error) {
+	scratch := pq.QuoteIdentifier(d.Schema) + "." + pq.QuoteIdentifier(domainScratchName)
+	var normalized string
+	err := a.withRollback(func(tx *sql.Tx) error {
+		create := fmt.Sprintf("create domain %s as %s default %s", scratch, d.BaseType, expr)
+		if _, err := tx.Exec(create); err != nil {
+			return fmt.Errorf("invalid default %q: %w", expr, err)
+		}
+		query := fmt.Sprintf("select typdefault from pg_type where oid = %s::regtype", pq.QuoteLiteral(scratch))
+		return tx.QueryRow(query).Scan(&normalized)
+	})
+	return normalized, err
+}
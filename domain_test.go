@@ -0,0 +1,196 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"testing"
+)
+
+func TestDbAsserts_DomainType(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	cases := []struct {
+		name string
+		d    DomainInfo
+		want bool
+	}{
+		{
+			name: "domain",
+			d: DomainInfo{
+				Name:      "test_code_dbasserts",
+				BaseType:  "character varying(32)",
+				IsNotNull: true,
+				Default:   "'none'::character varying",
+				Collation: "C",
+				Checks: map[string]string{
+					"test_code_not_empty": "length(value) > 0",
+					"test_code_lower":     "CHECK (value = lower(value))",
+				},
+			},
+			want: true,
+		},
+		{
+			name: "varchar-base-type",
+			d: DomainInfo{
+				Name:      "test_code_dbasserts",
+				BaseType:  "varchar(32)",
+				IsNotNull: true,
+				Default:   "'none'::character varying",
+				Collation: "C",
+				Checks: map[string]string{
+					"test_code_not_empty": "length(value) > 0",
+					"test_code_lower":     "CHECK (value = lower(value))",
+				},
+			},
+			want: true,
+		},
+		{
+			name: "default-without-cast",
+			d: DomainInfo{
+				Name:      "test_code_dbasserts",
+				BaseType:  "character varying(32)",
+				IsNotNull: true,
+				Default:   "'none'",
+				Collation: "C",
+				Checks: map[string]string{
+					"test_code_not_empty": "length(value) > 0",
+					"test_code_lower":     "CHECK (value = lower(value))",
+				},
+			},
+			want: true,
+		},
+		{
+			name: "public-id",
+			d: DomainInfo{
+				Schema:   "public",
+				Name:     "dbasserts_public_id",
+				BaseType: "text",
+				Checks: map[string]string{
+					"dbasserts_public_id_check": "length(trim(value)) > 10",
+				},
+			},
+			want: true,
+		},
+		{
+			name: "bad-check",
+			d: DomainInfo{
+				Name:     "dbasserts_public_id",
+				BaseType: "text",
+				Checks: map[string]string{
+					"dbasserts_public_id_check": "length(value) > 10",
+				},
+			},
+			want: false,
+		},
+		{
+			name: "missing-check",
+			d: DomainInfo{
+				Name:      "test_code_dbasserts",
+				BaseType:  "character varying(32)",
+				IsNotNull: true,
+				Default:   "'none'::character varying",
+				Collation: "C",
+				Checks: map[string]string{
+					"test_code_not_empty": "length(value) > 0",
+				},
+			},
+			want: false,
+		},
+		{
+			name: "bad-base-type",
+			d: DomainInfo{
+				Name:      "test_code_dbasserts",
+				BaseType:  "character varying(255)",
+				IsNotNull: true,
+				Default:   "'none'::character varying",
+				Collation: "C",
+				Checks: map[string]string{
+					"test_code_not_empty": "length(value) > 0",
+					"test_code_lower":     "CHECK (value = lower(value))",
+				},
+			},
+			want: false,
+		},
+		{
+			name: "nullable",
+			d: DomainInfo{
+				Name:      "test_code_dbasserts",
+				BaseType:  "character varying(32)",
+				Default:   "'none'::character varying",
+				Collation: "C",
+				Checks: map[string]string{
+					"test_code_not_empty": "length(value) > 0",
+					"test_code_lower":     "CHECK (value = lower(value))",
+				},
+			},
+			want: false,
+		},
+		{
+			name: "bad-default",
+			d: DomainInfo{
+				Name:      "test_code_dbasserts",
+				BaseType:  "character varying(32)",
+				IsNotNull: true,
+				Collation: "C",
+				Checks: map[string]string{
+					"test_code_not_empty": "length(value) > 0",
+					"test_code_lower":     "CHECK (value = lower(value))",
+				},
+			},
+			want: false,
+		},
+		{
+			name: "bad-collation",
+			d: DomainInfo{
+				Name:      "test_code_dbasserts",
+				BaseType:  "character varying(32)",
+				IsNotNull: true,
+				Default:   "'none'::character varying",
+				Checks: map[string]string{
+					"test_code_not_empty": "length(value) > 0",
+					"test_code_lower":     "CHECK (value = lower(value))",
+				},
+			},
+			want: false,
+		},
+		{
+			name: "not-domain",
+			d: DomainInfo{
+				Name: "public.test_status_dbasserts",
+			},
+			want: false,
+		},
+		{
+			name: "bad-domain",
+			d: DomainInfo{
+				Name: "bad_domain",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := a.DomainType(tt.d); got != tt.want {
+				t.Errorf("DomainType() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TableExists asserts tableName, a table, view or materialized view,
+// exists. When tableName isn't qualified and the DbAsserts Schema isn't set,
+// it may exist in any schema.
+func (a *DbAsserts) TableExists(tableName string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	exists, err := a.objectExists(tableName, tableSchemasQuery)
+	return a.assertExists(fmt.Sprintf("table %s", tableName), true, exists, err)
+}
+
+// TableNotExists asserts tableName, a table, view or materialized view,
+// doesn't exist.
+func (a *DbAsserts) TableNotExists(tableName string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	exists, err := a.objectExists(tableName, tableSchemasQuery)
+	return a.assertExists(fmt.Sprintf("table %s", tableName), false, exists, err)
+}
+
+// ColumnExists asserts colName exists in tableName, which must exist.
+func (a *DbAsserts) ColumnExists(tableName, colName string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	exists, err := a.columnExists(tableName, colName)
+	return a.assertExists(fmt.Sprintf("column %s.%s", tableName, colName), true, exists, err)
+}
+
+// ColumnNotExists asserts colName doesn't exist in tableName, which must
+// exist.
+func (a *DbAsserts) ColumnNotExists(tableName, colName string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	exists, err := a.columnExists(tableName, colName)
+	return a.assertExists(fmt.Sprintf("column %s.%s", tableName, colName), false, exists, err)
+}
+
+// TypeExists asserts the user-defined type typeName, such as an enum,
+// domain or composite type, exists. Built-in types, such as integer, the
+// row types of tables and array types aren't user-defined types. When
+// typeName isn't qualified and the DbAsserts Schema isn't set, it may exist
+// in any schema.
+func (a *DbAsserts) TypeExists(typeName string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	exists, err := a.objectExists(typeName, typeSchemasQuery)
+	return a.assertExists(fmt.Sprintf("type %s", typeName), true, exists, err)
+}
+
+// TypeNotExists asserts the user-defined type typeName doesn't exist.
+func (a *DbAsserts) TypeNotExists(typeName string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	exists, err := a.objectExists(typeName, typeSchemasQuery)
+	return a.assertExists(fmt.Sprintf("type %s", typeName), false, exists, err)
+}
+
+// FunctionExists asserts the function or procedure name with the argument
+// types args exists. When name isn't qualified and the DbAsserts Schema
+// isn't set, it may exist in any schema.
+func (a *DbAsserts) FunctionExists(name string, args ...string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	exists, err := a.functionExists(name, args)
+	return a.assertExists(fmt.Sprintf("function %s(%s)", name, strings.Join(args, ", ")), true, exists, err)
+}
+
+// FunctionNotExists asserts the function or procedure name with the
+// argument types args doesn't exist.
+func (a *DbAsserts) FunctionNotExists(name string, args ...string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	exists, err := a.functionExists(name, args)
+	return a.assertExists(fmt.Sprintf("function %s(%s)", name, strings.Join(args, ", ")), false, exists, err)
+}
+
+// IndexExists asserts indexName exists on tableName, which must exist.
+func (a *DbAsserts) IndexExists(tableName, indexName string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	exists, err := a.indexExists(tableName, indexName)
+	return a.assertExists(fmt.Sprintf("index %s on %s", indexName, tableName), true, exists, err)
+}
+
+// IndexNotExists asserts indexName doesn't exist on tableName, which must
+// exist.
+func (a *DbAsserts) IndexNotExists(tableName, indexName string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	exists, err := a.indexExists(tableName, indexName)
+	return a.assertExists(fmt.Sprintf("index %s on %s", indexName, tableName), false, exists, err)
+}
+
+// assertExists asserts object exists when want is true, or doesn't exist
+// otherwise. err is an error returned while checking if object exists.
+func (a *DbAsserts) assertExists(object string, want, exists bool, err error) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	switch {
+	case err != nil:
+		assert.FailNow(a.T, err.Error())
+		return false
+	case want == exists:
+		return true
+	case want:
+		assert.Fail(a.T, "does not exist", "%s does not exist", object)
+	default:
+		assert.Fail(a.T, "exists", "%s exists", object)
+	}
+	return false
+}
+
+func (a *DbAsserts) objectExists(name, schemaQuery string) (bool, error) {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schemas, err := a.findSchemas(name, schemaQuery)
+	if err != nil {
+		return false, err
+	}
+	return len(schemas) > 0, nil
+}
+
+func (a *DbAsserts) columnExists(tableName, colName string) (bool, error) {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, table, err := a.resolveTable(tableName)
+	if err != nil {
+		return false, err
+	}
+	const query = `
+select exists (
+	select
+	from pg_attribute a
+	join pg_class c on c.oid = a.attrelid
+	join pg_namespace n on n.oid = c.relnamespace
+	where n.nspname = $1
+		and c.relname = $2
+		and a.attname = $3
+		and a.attnum > 0
+		and not a.attisdropped
+)`
+	var exists bool
+	if err := a.Db.QueryRow(query, schema, table, colName).Scan(&exists); err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+func (a *DbAsserts) functionExists(name string, args []string) (bool, error) {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schemas, err := a.findSchemas(name, functionSchemasQuery)
+	if err != nil {
+		return false, err
+	}
+	_, fnName := splitName(name)
+	for _, schema := range schemas {
+		var exists bool
+		if err := a.Db.QueryRow(`select to_regprocedure($1) is not null`, regprocedure(schema, fnName, args)).Scan(&exists); err != nil {
+			return false, err
+		}
+		if exists {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (a *DbAsserts) indexExists(tableName, indexName string) (bool, error) {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, table, err := a.resolveTable(tableName)
+	if err != nil {
+		return false, err
+	}
+	const query = `
+select exists (
+	select
+	from pg_index i
+	join pg_class c on c.oid = i.indrelid
+	join pg_class ic on ic.oid = i.indexrelid
+	join pg_namespace n on n.oid = c.relnamespace
+	where n.nspname = $1
+		and c.relname = $2
+		and ic.relname = $3
+)`
+	var exists bool
+	if err := a.Db.QueryRow(query, schema, table, indexName).Scan(&exists); err != nil {
+		return false, err
+	}
+	return exists, nil
+}
@@ -0,0 +1,201 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"testing"
+)
+
+func TestDbAsserts_Exists(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	cases := []struct {
+		name   string
+		schema string
+		assert func(a *DbAsserts) bool
+		want   bool
+	}{
+		{
+			name:   "table",
+			assert: func(a *DbAsserts) bool { return a.TableExists("test_table_dbasserts") },
+			want:   true,
+		},
+		{
+			name:   "table-view",
+			assert: func(a *DbAsserts) bool { return a.TableExists("test_view_dbasserts") },
+			want:   true,
+		},
+		{
+			name:   "table-multi-schema",
+			assert: func(a *DbAsserts) bool { return a.TableExists("test_multi_schema_dbasserts") },
+			want:   true,
+		},
+		{
+			name:   "table-qualified",
+			assert: func(a *DbAsserts) bool { return a.TableExists("dbasserts_audit.test_multi_schema_dbasserts") },
+			want:   true,
+		},
+		{
+			name:   "table-bad-schema",
+			schema: "dbasserts_audit",
+			assert: func(a *DbAsserts) bool { return a.TableExists("test_table_dbasserts") },
+			want:   false,
+		},
+		{
+			name:   "table-missing",
+			assert: func(a *DbAsserts) bool { return a.TableExists("bad_table") },
+			want:   false,
+		},
+		{
+			name:   "table-not-exists",
+			assert: func(a *DbAsserts) bool { return a.TableNotExists("bad_table") },
+			want:   true,
+		},
+		{
+			name:   "table-not-exists-other-schema",
+			assert: func(a *DbAsserts) bool { return a.TableNotExists("dbasserts_audit.test_table_dbasserts") },
+			want:   true,
+		},
+		{
+			name:   "table-not-exists-exists",
+			assert: func(a *DbAsserts) bool { return a.TableNotExists("test_table_dbasserts") },
+			want:   false,
+		},
+		{
+			name:   "column",
+			assert: func(a *DbAsserts) bool { return a.ColumnExists("test_table_dbasserts", "public_id") },
+			want:   true,
+		},
+		{
+			name:   "column-missing",
+			assert: func(a *DbAsserts) bool { return a.ColumnExists("test_table_dbasserts", "bad_column") },
+			want:   false,
+		},
+		{
+			name:   "column-not-exists",
+			assert: func(a *DbAsserts) bool { return a.ColumnNotExists("test_table_dbasserts", "bad_column") },
+			want:   true,
+		},
+		{
+			name:   "column-not-exists-exists",
+			assert: func(a *DbAsserts) bool { return a.ColumnNotExists("test_table_dbasserts", "public_id") },
+			want:   false,
+		},
+		{
+			name:   "column-not-exists-bad-table",
+			assert: func(a *DbAsserts) bool { return a.ColumnNotExists("bad_table", "public_id") },
+			want:   false,
+		},
+		{
+			name:   "type",
+			assert: func(a *DbAsserts) bool { return a.TypeExists("test_status_dbasserts") },
+			want:   true,
+		},
+		{
+			name:   "type-not-exists",
+			assert: func(a *DbAsserts) bool { return a.TypeNotExists("bad_type") },
+			want:   true,
+		},
+		{
+			name:   "type-not-exists-exists",
+			assert: func(a *DbAsserts) bool { return a.TypeNotExists("public.test_status_dbasserts") },
+			want:   false,
+		},
+		{
+			name:   "type-builtin",
+			assert: func(a *DbAsserts) bool { return a.TypeExists("integer") },
+			want:   false,
+		},
+		{
+			name:   "type-table-row",
+			assert: func(a *DbAsserts) bool { return a.TypeExists("test_table_dbasserts") },
+			want:   false,
+		},
+		{
+			name:   "type-array",
+			assert: func(a *DbAsserts) bool { return a.TypeExists("_test_status_dbasserts") },
+			want:   false,
+		},
+		{
+			name:   "type-composite",
+			assert: func(a *DbAsserts) bool { return a.TypeExists("test_address_dbasserts") },
+			want:   true,
+		},
+		{
+			name:   "function",
+			assert: func(a *DbAsserts) bool { return a.FunctionExists("test_add_dbasserts", "int", "int") },
+			want:   true,
+		},
+		{
+			name:   "function-procedure",
+			assert: func(a *DbAsserts) bool { return a.FunctionExists("test_proc_dbasserts", "text") },
+			want:   true,
+		},
+		{
+			name:   "function-bad-args",
+			assert: func(a *DbAsserts) bool { return a.FunctionExists("test_add_dbasserts", "text") },
+			want:   false,
+		},
+		{
+			name:   "function-not-exists",
+			assert: func(a *DbAsserts) bool { return a.FunctionNotExists("test_add_dbasserts", "bigint", "bigint") },
+			want:   true,
+		},
+		{
+			name:   "function-not-exists-exists",
+			assert: func(a *DbAsserts) bool { return a.FunctionNotExists("test_add_dbasserts", "integer", "integer") },
+			want:   false,
+		},
+		{
+			name:   "index",
+			assert: func(a *DbAsserts) bool { return a.IndexExists("test_index_dbasserts", "test_index_tags_idx") },
+			want:   true,
+		},
+		{
+			name:   "index-missing",
+			assert: func(a *DbAsserts) bool { return a.IndexExists("test_index_dbasserts", "bad_idx") },
+			want:   false,
+		},
+		{
+			name:   "index-other-table",
+			assert: func(a *DbAsserts) bool { return a.IndexExists("test_table_dbasserts", "test_index_tags_idx") },
+			want:   false,
+		},
+		{
+			name:   "index-not-exists",
+			assert: func(a *DbAsserts) bool { return a.IndexNotExists("test_index_dbasserts", "bad_idx") },
+			want:   true,
+		},
+		{
+			name:   "index-not-exists-exists",
+			assert: func(a *DbAsserts) bool { return a.IndexNotExists("test_index_dbasserts", "test_index_tags_idx") },
+			want:   false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+			a.Schema = tt.schema
+
+			if got := tt.assert(a); got != tt.want {
+				t.Errorf("assert() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+// Extension asserts the extension name is installed with a version of at
+// least minVersion. When minVersion is empty, any version is valid.
+func (a *DbAsserts) Extension(name, minVersion string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	const query = `select extversion from pg_extension where extname = $1`
+	var version string
+	err := a.Db.QueryRow(query, name).Scan(&version)
+	switch {
+	case err == sql.ErrNoRows:
+		assert.Fail(a.T, "extension not installed", "extension %s is not installed", name)
+		return false
+	case err != nil:
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	if minVersion == "" || compareVersions(version, minVersion) >= 0 {
+		return true
+	}
+	assert.Fail(a.T, "extension version is not valid", "%s: extension version %s is older than %s", name, version, minVersion)
+	return false
+}
+
+// compareVersions compares the dot separated versions v1 and v2, returning
+// a negative number when v1 is older than v2, zero when they're equal and a
+// positive number otherwise. Numeric parts are compared as numbers, and
+// other parts, such as 1.0beta, as strings.
+func compareVersions(v1, v2 string) int {
+	parts1, parts2 := strings.Split(v1, "."), strings.Split(v2, ".")
+	for i := 0; i < len(parts1) || i < len(parts2); i++ {
+		var p1, p2 string
+		if i < len(parts1) {
+			p1 = parts1[i]
+		}
+		if i < len(parts2) {
+			p2 = parts2[i]
+		}
+		n1, err1 := strconv.Atoi(defaultString(p1, "0"))
+		n2, err2 := strconv.Atoi(defaultString(p2, "0"))
+		switch {
+		case err1 == nil && err2 == nil:
+			if n1 != n2 {
+				return n1 - n2
+			}
+		case p1 != p2:
+			return strings.Compare(p1, p2)
+		}
+	}
+	return 0
+}
+
+// createExtensions creates every extension of extensions which isn't
+// installed.
+func createExtensions(db *sql.DB, extensions ...string) error {
+	for _, name := range extensions {
+		if _, err := db.Exec(fmt.Sprintf("create extension if not exists %s", pq.QuoteIdentifier(name))); err != nil {
+			return fmt.Errorf("unable to create extension %s: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDbAsserts_Extension(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres", "pgcrypto", "citext")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	cases := []struct {
+		name       string
+		extension  string
+		minVersion string
+		want       bool
+	}{
+		{
+			name:      "plpgsql",
+			extension: "plpgsql",
+			want:      true,
+		},
+		{
+			name:       "pgcrypto",
+			extension:  "pgcrypto",
+			minVersion: "1.0",
+			want:       true,
+		},
+		{
+			name:      "citext",
+			extension: "citext",
+			want:      true,
+		},
+		{
+			name:       "old-version",
+			extension:  "pgcrypto",
+			minVersion: "99.0",
+			want:       false,
+		},
+		{
+			name:      "not-installed",
+			extension: "pg_trgm",
+			want:      false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := a.Extension(tt.extension, tt.minVersion); got != tt.want {
+				t.Errorf("Extension() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
+
+func Test_compareVersions(t *testing.T) {
+	cases := []struct {
+		v1   string
+		v2   string
+		want int
+	}{
+		{v1: "1.3", v2: "1.3", want: 0},
+		{v1: "1.3", v2: "1.3.0", want: 0},
+		{v1: "1.10", v2: "1.9", want: 1},
+		{v1: "1.2", v2: "1.10", want: -1},
+		{v1: "2", v2: "1.9", want: 1},
+		{v1: "1.0beta", v2: "1.0", want: 1},
+	}
+	for _, tt := range cases {
+		t.Run(tt.v1+"-"+tt.v2, func(t *testing.T) {
+			got := compareVersions(tt.v1, tt.v2)
+			switch {
+			case tt.want < 0:
+				assert.Negative(t, got)
+			case tt.want > 0:
+				assert.Positive(t, got)
+			default:
+				assert.Zero(t, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+// FunctionInfo defines a set of information about a function or procedure.
+type FunctionInfo struct {
+	// Schema of the function. When empty, the schema is resolved from Name
+	// or the DbAsserts Schema and isn't compared by Function.
+	Schema string
+
+	// Name of the function, optionally qualified with its schema
+	// (schema.function).
+	Name string
+
+	// Args are the argument types which, with Name, identify the function.
+	// Nil identifies a function without arguments.
+	Args []string
+
+	// Kind of the function: FUNCTION, PROCEDURE, AGGREGATE or WINDOW. Empty
+	// is FUNCTION.
+	Kind string
+
+	// ReturnType of the function as returned by pg_get_function_result, for
+	// example integer, trigger or SETOF text. Empty for procedures.
+	ReturnType string
+
+	// Language of the function, for example sql or plpgsql.
+	Language string
+
+	// Volatility of the function: IMMUTABLE, STABLE or VOLATILE. Empty is
+	// VOLATILE.
+	Volatility string
+
+	// IsSecurityDefiner defines if the function is SECURITY DEFINER.
+	IsSecurityDefiner bool
+
+	// SearchPath is the search_path configured for the function with SET,
+	// or empty when it isn't set.
+	SearchPath string
+}
+
+// functionSchemasQuery finds the schemas containing a function named $1.
+const functionSchemasQuery = `
+select distinct n.nspname
+from pg_proc p
+join pg_namespace n on n.oid = p.pronamespace
+where p.proname = $1
+	and n.nspname not in ('pg_catalog', 'information_schema')
+order by n.nspname`
+
+var (
+	functionKinds = map[string]string{
+		"f": "FUNCTION",
+		"p": "PROCEDURE",
+		"a": "AGGREGATE",
+		"w": "WINDOW",
+	}
+	functionVolatility = map[string]string{
+		"i": "IMMUTABLE",
+		"s": "STABLE",
+		"v": "VOLATILE",
+	}
+)
+
+// Function asserts fn FunctionInfo is valid. The function is found by its
+// Name and Args.
+func (a *DbAsserts) Function(fn FunctionInfo) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	dbFunction, err := a.getFunction(qualifyName(fn.Schema, fn.Name), fn.Args...)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	want := fn
+	want.Kind = defaultString(strings.ToUpper(want.Kind), "FUNCTION")
+	want.Language = strings.ToLower(want.Language)
+	want.Volatility = defaultString(strings.ToUpper(want.Volatility), "VOLATILE")
+	want.SearchPath = normalizeSearchPath(want.SearchPath)
+	if want.ReturnType, err = a.normalizeType(want.ReturnType); err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	dbFunction.Name = fn.Name
+	if fn.Schema == "" {
+		dbFunction.Schema = ""
+	}
+	return assert.Equal(a.T, want, *dbFunction, "%s(%s): function is not valid", fn.Name, strings.Join(fn.Args, ", "))
+}
+
+func (a *DbAsserts) getFunction(name string, args ...string) (*FunctionInfo, error) {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, signature, err := a.functionSignature(name, args...)
+	if err != nil {
+		return nil, err
+	}
+	const query = `
+select
+	p.prokind,
+	coalesce(pg_get_function_result(p.oid), ''),
+	l.lanname,
+	p.provolatile,
+	p.prosecdef,
+	coalesce((
+		select substr(c, length('search_path=') + 1)
+		from unnest(p.proconfig) as c
+		where c like 'search_path=%'
+	), '')
+from pg_proc p
+join pg_language l on l.oid = p.prolang
+where p.oid = to_regprocedure($1)`
+	fn := FunctionInfo{
+		Schema: schema,
+		Name:   name,
+		Args:   args,
+	}
+	var kind, volatility string
+	err = a.Db.QueryRow(query, signature).Scan(
+		&kind,
+		&fn.ReturnType,
+		&fn.Language,
+		&volatility,
+		&fn.IsSecurityDefiner,
+		&fn.SearchPath,
+	)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, fmt.Errorf("function %s not found", signature)
+	case err != nil:
+		return nil, err
+	}
+	fn.Kind = functionKinds[kind]
+	fn.Volatility = functionVolatility[volatility]
+	fn.SearchPath = normalizeSearchPath(fn.SearchPath)
+	return &fn, nil
+}
+
+// functionSignature resolves the function name and returns its schema and
+// signature, which can be cast to regprocedure.
+func (a *DbAsserts) functionSignature(name string, args ...string) (string, string, error) {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, fnName, err := a.resolveName("function", name, functionSchemasQuery)
+	if err != nil {
+		return "", "", err
+	}
+	return schema, regprocedure(schema, fnName, args), nil
+}
+
+// regprocedure returns the signature of the function name in schema with
+// the argument types args, which can be cast to regprocedure.
+func regprocedure(schema, name string, args []string) string {
+	return fmt.Sprintf("%s.%s(%s)", pq.QuoteIdentifier(schema), pq.QuoteIdentifier(name), strings.Join(args, ", "))
+}
+
+// normalizeType has the database render typeName using its canonical name,
+// for example int is rendered as integer. Names which aren't types, such
+// as SETOF text, are returned unchanged.
+func (a *DbAsserts) normalizeType(typeName string) (string, error) {
+	if typeName == "" {
+		return "", nil
+	}
+	const query = `select coalesce(format_type(to_regtype($1), null), $1)`
+	var normalized string
+	if err := a.Db.QueryRow(query, typeName).Scan(&normalized); err != nil {
+		return "", err
+	}
+	return normalized, nil
+}
+
+// typeScratchName names the scratch table and column used to normalize
+// type names with their modifiers.
+const typeScratchName = "dbassert_type"
+
+// normalizeColumnType has the database render typeName, including its
+// modifiers, as format_type renders a column of typeName, for example
+// varchar(32) is rendered as character varying(32). It also returns
+// whether typeName has modifiers.
+func (a *DbAsserts) normalizeColumnType(typeName string) (string, bool, error) {
+	if typeName == "" {
+		return "", false, nil
+	}
+	var normalized string
+	var typmod int64
+	err := a.withRollback(func(tx *sql.Tx) error {
+		create := fmt.Sprintf("create temp table %[1]s (%[1]s %[2]s)", typeScratchName, typeName)
+		if _, err := tx.Exec(create); err != nil {
+			return fmt.Errorf("invalid type %q: %w", typeName, err)
+		}
+		query := fmt.Sprintf("select format_type(atttypid, atttypmod), atttypmod from pg_attribute where attrelid = 'pg_temp.%[1]s'::regclass and attname = '%[1]s'",
+			typeScratchName)
+		return tx.QueryRow(query).Scan(&normalized, &typmod)
+	})
+	return normalized, typmod >= 0, err
+}
+
+// normalizeSearchPath returns the schemas of searchPath separated by ", ".
+func normalizeSearchPath(searchPath string) string {
+	if searchPath == "" {
+		return ""
+	}
+	schemas := strings.Split(searchPath, ",")
+	for i, s := range schemas {
+		schemas[i] = strings.TrimSpace(s)
+	}
+	return strings.Join(schemas, ", ")
+}
@@ -0,0 +1,178 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"testing"
+)
+
+func TestDbAsserts_Function(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	cases := []struct {
+		name string
+		fn   FunctionInfo
+		want bool
+	}{
+		{
+			name: "function",
+			fn: FunctionInfo{
+				Name:              "test_add_dbasserts",
+				Args:              []string{"int", "integer"},
+				ReturnType:        "int",
+				Language:          "sql",
+				Volatility:        "immutable",
+				IsSecurityDefiner: true,
+				SearchPath:        "pg_catalog,public",
+			},
+			want: true,
+		},
+		{
+			name: "qualified",
+			fn: FunctionInfo{
+				Name:              "public.test_add_dbasserts",
+				Args:              []string{"int", "integer"},
+				ReturnType:        "int",
+				Language:          "sql",
+				Volatility:        "immutable",
+				IsSecurityDefiner: true,
+				SearchPath:        "pg_catalog,public",
+			},
+			want: true,
+		},
+		{
+			name: "trigger-function",
+			fn: FunctionInfo{
+				Schema:     "public",
+				Name:       "test_update_time_dbasserts",
+				ReturnType: "trigger",
+				Language:   "plpgsql",
+			},
+			want: true,
+		},
+		{
+			name: "procedure",
+			fn: FunctionInfo{
+				Name:     "test_proc_dbasserts",
+				Args:     []string{"text"},
+				Kind:     "procedure",
+				Language: "sql",
+			},
+			want: true,
+		},
+		{
+			name: "bad-args",
+			fn: FunctionInfo{
+				Name:              "test_add_dbasserts",
+				Args:              []string{"int"},
+				ReturnType:        "int",
+				Language:          "sql",
+				Volatility:        "immutable",
+				IsSecurityDefiner: true,
+				SearchPath:        "pg_catalog,public",
+			},
+			want: false,
+		},
+		{
+			name: "bad-return-type",
+			fn: FunctionInfo{
+				Name:              "test_add_dbasserts",
+				Args:              []string{"int", "integer"},
+				ReturnType:        "bigint",
+				Language:          "sql",
+				Volatility:        "immutable",
+				IsSecurityDefiner: true,
+				SearchPath:        "pg_catalog,public",
+			},
+			want: false,
+		},
+		{
+			name: "bad-volatility",
+			fn: FunctionInfo{
+				Name:              "test_add_dbasserts",
+				Args:              []string{"int", "integer"},
+				ReturnType:        "int",
+				Language:          "sql",
+				IsSecurityDefiner: true,
+				SearchPath:        "pg_catalog,public",
+			},
+			want: false,
+		},
+		{
+			name: "not-security-definer",
+			fn: FunctionInfo{
+				Name:       "test_add_dbasserts",
+				Args:       []string{"int", "integer"},
+				ReturnType: "int",
+				Language:   "sql",
+				Volatility: "immutable",
+				SearchPath: "pg_catalog,public",
+			},
+			want: false,
+		},
+		{
+			name: "bad-search-path",
+			fn: FunctionInfo{
+				Name:              "test_add_dbasserts",
+				Args:              []string{"int", "integer"},
+				ReturnType:        "int",
+				Language:          "sql",
+				Volatility:        "immutable",
+				IsSecurityDefiner: true,
+				SearchPath:        "public",
+			},
+			want: false,
+		},
+		{
+			name: "bad-language",
+			fn: FunctionInfo{
+				Name:              "test_add_dbasserts",
+				Args:              []string{"int", "integer"},
+				ReturnType:        "int",
+				Language:          "plpgsql",
+				Volatility:        "immutable",
+				IsSecurityDefiner: true,
+				SearchPath:        "pg_catalog,public",
+			},
+			want: false,
+		},
+		{
+			name: "bad-function",
+			fn: FunctionInfo{
+				Name:              "bad_function",
+				Args:              []string{"int", "integer"},
+				ReturnType:        "int",
+				Language:          "sql",
+				Volatility:        "immutable",
+				IsSecurityDefiner: true,
+				SearchPath:        "pg_catalog,public",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := a.Function(tt.fn); got != tt.want {
+				t.Errorf("Function() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+// IndexInfo defines a set of information about an index.
+type IndexInfo struct {
+	// Schema of the index's table. When empty, the schema is resolved from
+	// TableName or the DbAsserts Schema and isn't compared by Index.
+	Schema string
+
+	// TableName for the index, optionally qualified with its schema
+	// (schema.table).
+	TableName string
+
+	// Name of the index. When empty, the index is found using Columns and
+	// its name isn't compared by Index.
+	Name string
+
+	// Columns are the key columns or expressions of the index, in order.
+	Columns []string
+
+	// Include are the non-key columns of the index (INCLUDE), in order.
+	Include []string
+
+	// Method is the access method of the index: btree, hash, gist, spgist,
+	// gin or brin. Empty is btree.
+	Method string
+
+	// IsUnique defines if the index is unique.
+	IsUnique bool
+
+	// Predicate is the WHERE clause of a partial index.
+	Predicate string
+}
+
+// indexScratchName names the scratch table and index used to normalize
+// index expressions.
+const indexScratchName = "dbassert_index"
+
+// indexExprsSelect selects the key columns, non-key columns and predicate
+// of the index ix, as scanned into IndexInfo.
+const indexExprsSelect = `
+	array(
+		select pg_get_indexdef(ix.indexrelid, k, true)
+		from generate_series(1, ix.indnkeyatts) as k
+		order by k
+	),
+	array(
+		select pg_get_indexdef(ix.indexrelid, k, true)
+		from generate_series(ix.indnkeyatts + 1, ix.indnatts) as k
+		order by k
+	),
+	coalesce(pg_get_expr(ix.indpred, ix.indrelid, true), '')`
+
+// Index asserts idx IndexInfo is valid. The index is found by its Name or,
+// when Name is empty, by its Columns. Expressions in Columns and Predicate
+// are compared after being normalized by the database, so they don't need
+// to match the text returned by pg_get_indexdef.
+func (a *DbAsserts) Index(idx IndexInfo) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, table, err := a.resolveTable(qualifyName(idx.Schema, idx.TableName))
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	dbIndexes, err := a.getIndexes(schema, table)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	want := idx
+	want.Method = defaultString(strings.ToLower(want.Method), "btree")
+	if want, err = a.normalizeIndex(schema, table, want); err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	var dbIndex *IndexInfo
+	for _, i := range dbIndexes {
+		if (idx.Name != "" && i.Name == idx.Name) ||
+			(idx.Name == "" && strings.Join(i.Columns, ",") == strings.Join(want.Columns, ",")) {
+			dbIndex = i
+			break
+		}
+	}
+	if dbIndex == nil {
+		assert.Fail(a.T, "index not found", "%s: index %s%v not found", idx.TableName, idx.Name, idx.Columns)
+		return false
+	}
+	dbIndex.TableName = idx.TableName
+	if idx.Schema == "" {
+		dbIndex.Schema = ""
+	}
+	if idx.Name == "" {
+		dbIndex.Name = ""
+	}
+	return assert.Equal(a.T, want, *dbIndex, "%s: index %s%v is not valid", idx.TableName, idx.Name, idx.Columns)
+}
+
+func (a *DbAsserts) getIndexes(schema, table string) ([]*IndexInfo, error) {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	query := `
+select
+	i.relname,
+	am.amname,
+	ix.indisunique,` + indexExprsSelect + `
+from pg_index ix
+join pg_class i on i.oid = ix.indexrelid
+join pg_am am on am.oid = i.relam
+join pg_class c on c.oid = ix.indrelid
+join pg_namespace n on n.oid = c.relnamespace
+where n.nspname = $1 and c.relname = $2
+order by i.relname`
+	rows, err := a.Db.Query(query, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var indexes []*IndexInfo
+	for rows.Next() {
+		idx := IndexInfo{
+			Schema: schema,
+		}
+		if err := rows.Scan(
+			&idx.Name,
+			&idx.Method,
+			&idx.IsUnique,
+			pq.Array(&idx.Columns),
+			pq.Array(&idx.Include),
+			&idx.Predicate,
+		); err != nil {
+			return nil, err
+		}
+		indexes = append(indexes, &idx)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return indexes, nil
+}
+
+// normalizeIndex has the database render the Columns, Include and
+// Predicate of idx by creating a scratch index with them, and idx's Method,
+// on a scratch copy of the table in schema.
+func (a *DbAsserts) normalizeIndex(schema, table string, idx IndexInfo) (IndexInfo, error) {
+	if len(idx.Columns) == 0 {
+		return idx, nil
+	}
+	create := fmt.Sprintf("create temp table %[1]s (like %[2]s.%[3]s); create index %[1]s_idx on %[1]s using %[4]s (%[5]s)",
+		indexScratchName, pq.QuoteIdentifier(schema), pq.QuoteIdentifier(table), idx.Method, strings.Join(idx.Columns, ", "))
+	if len(idx.Include) > 0 {
+		create += fmt.Sprintf(" include (%s)", strings.Join(idx.Include, ", "))
+	}
+	if idx.Predicate != "" {
+		create += " where " + idx.Predicate
+	}
+	err := a.withRollback(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(create); err != nil {
+			return fmt.Errorf("invalid index %v: %w", idx.Columns, err)
+		}
+		query := fmt.Sprintf("select %s from pg_index ix where ix.indexrelid = 'pg_temp.%s_idx'::regclass",
+			indexExprsSelect, indexScratchName)
+		idx.Columns, idx.Include = nil, nil
+		return tx.QueryRow(query).Scan(pq.Array(&idx.Columns), pq.Array(&idx.Include), &idx.Predicate)
+	})
+	return idx, err
+}
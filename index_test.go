@@ -0,0 +1,183 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"testing"
+)
+
+func TestDbAsserts_Index(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	cases := []struct {
+		name string
+		idx  IndexInfo
+		want bool
+	}{
+		{
+			name: "partial-include",
+			idx: IndexInfo{
+				TableName: "test_index_dbasserts",
+				Name:      "test_index_tenant_name_idx",
+				Columns:   []string{"tenant_id", "name"},
+				Include:   []string{"email"},
+				Predicate: "name is not null",
+			},
+			want: true,
+		},
+		{
+			name: "by-columns",
+			idx: IndexInfo{
+				TableName: "test_index_dbasserts",
+				Columns:   []string{"tenant_id", "name"},
+				Include:   []string{"email"},
+				Method:    "BTREE",
+				Predicate: "name is not null",
+			},
+			want: true,
+		},
+		{
+			name: "bad-predicate",
+			idx: IndexInfo{
+				TableName: "test_index_dbasserts",
+				Name:      "test_index_tenant_name_idx",
+				Columns:   []string{"tenant_id", "name"},
+				Include:   []string{"email"},
+				Predicate: "name is null",
+			},
+			want: false,
+		},
+		{
+			name: "missing-include",
+			idx: IndexInfo{
+				TableName: "test_index_dbasserts",
+				Name:      "test_index_tenant_name_idx",
+				Columns:   []string{"tenant_id", "name"},
+				Predicate: "name is not null",
+			},
+			want: false,
+		},
+		{
+			name: "bad-column-order",
+			idx: IndexInfo{
+				TableName: "test_index_dbasserts",
+				Name:      "test_index_tenant_name_idx",
+				Columns:   []string{"name", "tenant_id"},
+				Include:   []string{"email"},
+				Predicate: "name is not null",
+			},
+			want: false,
+		},
+		{
+			name: "expression-unique",
+			idx: IndexInfo{
+				TableName: "test_index_dbasserts",
+				Name:      "test_index_lower_email_idx",
+				Columns:   []string{"LOWER(email)"},
+				IsUnique:  true,
+			},
+			want: true,
+		},
+		{
+			name: "expression-whitespace",
+			idx: IndexInfo{
+				TableName: "test_index_dbasserts",
+				Name:      "test_index_lower_email_idx",
+				Columns:   []string{"lower(  email )"},
+				IsUnique:  true,
+			},
+			want: true,
+		},
+		{
+			name: "predicate-without-cast",
+			idx: IndexInfo{
+				TableName: "test_index_dbasserts",
+				Columns:   []string{"name"},
+				Predicate: "name <> ''",
+			},
+			want: true,
+		},
+		{
+			name: "not-unique",
+			idx: IndexInfo{
+				TableName: "test_index_dbasserts",
+				Name:      "test_index_lower_email_idx",
+				Columns:   []string{"lower(email)"},
+			},
+			want: false,
+		},
+		{
+			name: "gin",
+			idx: IndexInfo{
+				TableName: "test_index_dbasserts",
+				Columns:   []string{"tags"},
+				Method:    "gin",
+			},
+			want: true,
+		},
+		{
+			name: "brin",
+			idx: IndexInfo{
+				Schema:    "public",
+				TableName: "test_index_dbasserts",
+				Name:      "test_index_create_time_idx",
+				Columns:   []string{"create_time"},
+				Method:    "brin",
+			},
+			want: true,
+		},
+		{
+			name: "bad-method",
+			idx: IndexInfo{
+				TableName: "test_index_dbasserts",
+				Name:      "test_index_create_time_idx",
+				Columns:   []string{"create_time"},
+			},
+			want: false,
+		},
+		{
+			name: "bad-index",
+			idx: IndexInfo{
+				TableName: "test_index_dbasserts",
+				Name:      "bad_index",
+			},
+			want: false,
+		},
+		{
+			name: "bad-table",
+			idx: IndexInfo{
+				TableName: "bad_table",
+				Name:      "test_index_tenant_name_idx",
+				Columns:   []string{"tenant_id", "name"},
+				Include:   []string{"email"},
+				Predicate: "name is not null",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := a.Index(tt.idx); got != tt.want {
+				t.Errorf("Index() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+// PartitionInfo defines a set of information about a partition of a
+// partitioned table.
+type PartitionInfo struct {
+	// Schema of the partitioned table. When empty, the schema is resolved
+	// from TableName or the DbAsserts Schema and isn't compared by
+	// Partition.
+	Schema string
+
+	// TableName of the partitioned table, optionally qualified with its
+	// schema (schema.table).
+	TableName string
+
+	// Name of the partition, optionally qualified with its schema
+	// (schema.table).
+	Name string
+
+	// Bound of the partition, for example FOR VALUES FROM ('2024-01-01') TO
+	// ('2024-02-01'), FOR VALUES IN ('eu'), or DEFAULT for a default
+	// partition. FOR VALUES may be omitted. It's compared after being
+	// normalized by the database.
+	Bound string
+}
+
+// partitionScratchName names the scratch partitioned table used to
+// normalize partition keys and bounds, and its scratch partition is named
+// with the suffix _bound.
+const partitionScratchName = "dbassert_partition"
+
+// Partitioned asserts tableName is partitioned using strategy, RANGE, LIST
+// or HASH, with the partition keys keys, in order. Keys are column names or
+// expressions, written as in PARTITION BY, so expressions other than
+// function calls are parenthesized, for example (create_time::date). The
+// keys are compared after being normalized by the database.
+func (a *DbAsserts) Partitioned(tableName, strategy string, keys ...string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, table, err := a.resolveTable(tableName)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	keyDef, err := a.getPartitionKey(schema, table)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	if keyDef == "" {
+		assert.Fail(a.T, "table is not partitioned", "%s: table is not partitioned", tableName)
+		return false
+	}
+	want, err := a.normalizePartitionKey(schema, table, fmt.Sprintf("%s (%s)", strategy, strings.Join(keys, ", ")))
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	return assert.Equal(a.T, want, keyDef, "%s: partition key is not valid", tableName)
+}
+
+// Partition asserts p PartitionInfo is valid: its Name is a partition of
+// TableName with the Bound.
+func (a *DbAsserts) Partition(p PartitionInfo) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, table, err := a.resolveTable(qualifyName(p.Schema, p.TableName))
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	dbPartition, err := a.getPartition(p.Name)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	if dbPartition.Schema != schema || dbPartition.TableName != table {
+		assert.Fail(a.T, "invalid partition", "%s: %s is not a partition of %s", p.Name, p.Name, p.TableName)
+		return false
+	}
+	want := p
+	if want.Bound, err = a.normalizeBound(schema, table, p.Bound); err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	dbPartition.TableName = p.TableName
+	dbPartition.Name = p.Name
+	if p.Schema == "" {
+		dbPartition.Schema = ""
+	}
+	return assert.Equal(a.T, want, *dbPartition, "%s: partition is not valid", p.Name)
+}
+
+// DefaultPartition asserts the partitioned table tableName has a default
+// partition.
+func (a *DbAsserts) DefaultPartition(tableName string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, table, err := a.resolveTable(tableName)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	const query = `
+select coalesce(dc.relname, '')
+from pg_partitioned_table pt
+join pg_class c on c.oid = pt.partrelid
+join pg_namespace n on n.oid = c.relnamespace
+left join pg_class dc on dc.oid = pt.partdefid
+where n.nspname = $1 and c.relname = $2`
+	var name string
+	err = a.Db.QueryRow(query, schema, table).Scan(&name)
+	switch {
+	case err == sql.ErrNoRows:
+		assert.Fail(a.T, "table is not partitioned", "%s: table is not partitioned", tableName)
+		return false
+	case err != nil:
+		assert.FailNow(a.T, err.Error())
+		return false
+	case name == "":
+		assert.Fail(a.T, "no default partition", "%s: table has no default partition", tableName)
+		return false
+	}
+	if l, ok := a.T.(TLogger); ok {
+		l.Logf("%s: default partition is %s", tableName, name)
+	}
+	return true
+}
+
+// getPartitionKey returns the partition key of the table in schema, as
+// returned by pg_get_partkeydef, or an empty string when it isn't
+// partitioned.
+func (a *DbAsserts) getPartitionKey(schema, table string) (string, error) {
+	const query = `
+select coalesce(pg_get_partkeydef(c.oid), '')
+from pg_class c
+join pg_namespace n on n.oid = c.relnamespace
+where n.nspname = $1 and c.relname = $2`
+	var keyDef string
+	err := a.Db.QueryRow(query, schema, table).Scan(&keyDef)
+	switch {
+	case err == sql.ErrNoRows:
+		return "", fmt.Errorf("table %s.%s not found", schema, table)
+	case err != nil:
+		return "", err
+	}
+	return keyDef, nil
+}
+
+func (a *DbAsserts) getPartition(name string) (*PartitionInfo, error) {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, table, err := a.resolveTable(name)
+	if err != nil {
+		return nil, err
+	}
+	const query = `
+select pn.nspname, pc.relname, pg_get_expr(c.relpartbound, c.oid)
+from pg_class c
+join pg_namespace n on n.oid = c.relnamespace
+join pg_inherits i on i.inhrelid = c.oid
+join pg_class pc on pc.oid = i.inhparent
+join pg_namespace pn on pn.oid = pc.relnamespace
+where n.nspname = $1 and c.relname = $2 and c.relispartition`
+	p := PartitionInfo{
+		Name: table,
+	}
+	err = a.Db.QueryRow(query, schema, table).Scan(&p.Schema, &p.TableName, &p.Bound)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, fmt.Errorf("%s is not a partition", name)
+	case err != nil:
+		return nil, err
+	}
+	return &p, nil
+}
+
+// normalizePartitionKey has the database render keyDef, such as
+// RANGE (create_time), by creating a scratch copy of the table in schema
+// partitioned by keyDef.
+func (a *DbAsserts) normalizePartitionKey(schema, table, keyDef string) (string, error) {
+	create := fmt.Sprintf("create temp table %[1]s (like %[2]s.%[3]s) partition by %[4]s",
+		partitionScratchName, pq.QuoteIdentifier(schema), pq.QuoteIdentifier(table), keyDef)
+	query := fmt.Sprintf("select pg_get_partkeydef('pg_temp.%s'::regclass)", partitionScratchName)
+	var normalized string
+	err := a.withRollback(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(create); err != nil {
+			return fmt.Errorf("invalid partition key %q: %w", keyDef, err)
+		}
+		return tx.QueryRow(query).Scan(&normalized)
+	})
+	return normalized, err
+}
+
+// normalizeBound has the database render bound, by creating a scratch
+// partition with bound of a scratch copy of the partitioned table in
+// schema.
+func (a *DbAsserts) normalizeBound(schema, table, bound string) (string, error) {
+	keyDef, err := a.getPartitionKey(schema, table)
+	if err != nil {
+		return "", err
+	}
+	bound = strings.TrimSpace(bound)
+	if upper := strings.ToUpper(bound); upper != "DEFAULT" && !strings.HasPrefix(upper, "FOR VALUES") {
+		bound = "for values " + bound
+	}
+	create := fmt.Sprintf("create temp table %[1]s (like %[2]s.%[3]s) partition by %[4]s; create temp table %[1]s_bound partition of %[1]s %[5]s",
+		partitionScratchName, pq.QuoteIdentifier(schema), pq.QuoteIdentifier(table), keyDef, bound)
+	query := fmt.Sprintf("select pg_get_expr(relpartbound, oid) from pg_class where oid = 'pg_temp.%s_bound'::regclass", partitionScratchName)
+	var normalized string
+	err = a.withRollback(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(create); err != nil {
+			return fmt.Errorf("invalid partition bound %q: %w", bound, err)
+		}
+		return tx.QueryRow(query).Scan(&normalized)
+	})
+	return normalized, err
+}
@@ -0,0 +1,275 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"testing"
+)
+
+func TestDbAsserts_Partitioned(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	cases := []struct {
+		name      string
+		tableName string
+		strategy  string
+		keys      []string
+		want      bool
+	}{
+		{
+			name:      "range",
+			tableName: "test_event_dbasserts",
+			strategy:  "range",
+			keys:      []string{"create_time"},
+			want:      true,
+		},
+		{
+			name:      "list-expression",
+			tableName: "test_region_dbasserts",
+			strategy:  "LIST",
+			keys:      []string{"LOWER(region)"},
+			want:      true,
+		},
+		{
+			name:      "range-cast-expression",
+			tableName: "test_daily_event_dbasserts",
+			strategy:  "range",
+			keys:      []string{"(create_time::date)"},
+			want:      true,
+		},
+		{
+			name:      "range-bad-cast-expression",
+			tableName: "test_daily_event_dbasserts",
+			strategy:  "range",
+			keys:      []string{"(create_time::time)"},
+			want:      false,
+		},
+		{
+			name:      "hash",
+			tableName: "test_hash_dbasserts",
+			strategy:  "hash",
+			keys:      []string{"tenant_id", "id"},
+			want:      true,
+		},
+		{
+			name:      "bad-key-order",
+			tableName: "test_hash_dbasserts",
+			strategy:  "hash",
+			keys:      []string{"id", "tenant_id"},
+			want:      false,
+		},
+		{
+			name:      "bad-strategy",
+			tableName: "test_event_dbasserts",
+			strategy:  "list",
+			keys:      []string{"create_time"},
+			want:      false,
+		},
+		{
+			name:      "not-partitioned",
+			tableName: "test_table_dbasserts",
+			strategy:  "range",
+			keys:      []string{"id"},
+			want:      false,
+		},
+		{
+			name:      "bad-table",
+			tableName: "bad_table",
+			strategy:  "range",
+			keys:      []string{"id"},
+			want:      false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := a.Partitioned(tt.tableName, tt.strategy, tt.keys...); got != tt.want {
+				t.Errorf("Partitioned() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
+
+func TestDbAsserts_Partition(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	cases := []struct {
+		name      string
+		partition PartitionInfo
+		want      bool
+	}{
+		{
+			name: "range",
+			partition: PartitionInfo{
+				TableName: "test_event_dbasserts",
+				Name:      "test_event_2024_01_dbasserts",
+				Bound:     "from ('2024-01-01') to ('2024-02-01')",
+			},
+			want: true,
+		},
+		{
+			name: "range-rendered",
+			partition: PartitionInfo{
+				Schema:    "public",
+				TableName: "test_event_dbasserts",
+				Name:      "public.test_event_2024_02_dbasserts",
+				Bound:     "FOR VALUES FROM ('2024-02-01 00:00:00') TO ('2024-03-01 00:00:00')",
+			},
+			want: true,
+		},
+		{
+			name: "default",
+			partition: PartitionInfo{
+				TableName: "test_event_dbasserts",
+				Name:      "test_event_default_dbasserts",
+				Bound:     "default",
+			},
+			want: true,
+		},
+		{
+			name: "list",
+			partition: PartitionInfo{
+				TableName: "test_region_dbasserts",
+				Name:      "test_region_eu_dbasserts",
+				Bound:     "in ('eu', 'uk')",
+			},
+			want: true,
+		},
+		{
+			name: "hash",
+			partition: PartitionInfo{
+				TableName: "test_hash_dbasserts",
+				Name:      "test_hash_0_dbasserts",
+				Bound:     "with (modulus 2, remainder 0)",
+			},
+			want: true,
+		},
+		{
+			name: "bad-bound",
+			partition: PartitionInfo{
+				TableName: "test_event_dbasserts",
+				Name:      "test_event_2024_01_dbasserts",
+				Bound:     "from ('2024-01-01') to ('2024-03-01')",
+			},
+			want: false,
+		},
+		{
+			name: "invalid-bound",
+			partition: PartitionInfo{
+				TableName: "test_event_dbasserts",
+				Name:      "test_event_2024_01_dbasserts",
+				Bound:     "in ('2024-01-01')",
+			},
+			want: false,
+		},
+		{
+			name: "other-table",
+			partition: PartitionInfo{
+				TableName: "test_region_dbasserts",
+				Name:      "test_event_2024_01_dbasserts",
+				Bound:     "from ('2024-01-01') to ('2024-02-01')",
+			},
+			want: false,
+		},
+		{
+			name: "not-partition",
+			partition: PartitionInfo{
+				TableName: "test_event_dbasserts",
+				Name:      "test_table_dbasserts",
+				Bound:     "default",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := a.Partition(tt.partition); got != tt.want {
+				t.Errorf("Partition() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
+
+func TestDbAsserts_DefaultPartition(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	cases := []struct {
+		name      string
+		tableName string
+		want      bool
+	}{
+		{
+			name:      "default",
+			tableName: "test_event_dbasserts",
+			want:      true,
+		},
+		{
+			name:      "no-default",
+			tableName: "test_region_dbasserts",
+			want:      false,
+		},
+		{
+			name:      "not-partitioned",
+			tableName: "test_table_dbasserts",
+			want:      false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := a.DefaultPartition(tt.tableName); got != tt.want {
+				t.Errorf("DefaultPartition() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
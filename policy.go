@@ -0,0 +1,194 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+// PolicyInfo defines a set of information about a row level security
+// policy.
+type PolicyInfo struct {
+	// Schema of the policy's table. When empty, the schema is resolved from
+	// TableName or the DbAsserts Schema and isn't compared by Policy.
+	Schema string
+
+	// TableName of the policy, optionally qualified with its schema
+	// (schema.table).
+	TableName string
+
+	// Name of the policy.
+	Name string
+
+	// Command the policy applies to: ALL, SELECT, INSERT, UPDATE or
+	// DELETE. Empty is ALL.
+	Command string
+
+	// Permissive of the policy: PERMISSIVE or RESTRICTIVE. Empty is
+	// PERMISSIVE.
+	Permissive string
+
+	// Roles the policy applies to, in any order. Empty is public.
+	Roles []string
+
+	// Using is the policy's USING expression. It's compared after being
+	// normalized by the database.
+	Using string
+
+	// WithCheck is the policy's WITH CHECK expression. It's compared after
+	// being normalized by the database.
+	WithCheck string
+}
+
+// policyScratchName names the scratch table and policy used to normalize
+// policy expressions.
+const policyScratchName = "dbassert_policy"
+
+// RowSecurityEnabled asserts row level security is enabled on tableName.
+func (a *DbAsserts) RowSecurityEnabled(tableName string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	enabled, _, err := a.getRowSecurity(tableName)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	if enabled {
+		return true
+	}
+	assert.Fail(a.T, "row security is not enabled", "%s: row level security is not enabled", tableName)
+	return false
+}
+
+// RowSecurityForced asserts row level security is enabled and forced on
+// tableName, so it also applies to the table's owner.
+func (a *DbAsserts) RowSecurityForced(tableName string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	enabled, forced, err := a.getRowSecurity(tableName)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	if enabled && forced {
+		return true
+	}
+	assert.Fail(a.T, "row security is not forced", "%s: row level security is not enabled and forced", tableName)
+	return false
+}
+
+// Policy asserts p PolicyInfo is valid. The policy is found by its
+// TableName and Name.
+func (a *DbAsserts) Policy(p PolicyInfo) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	dbPolicy, err := a.getPolicy(qualifyName(p.Schema, p.TableName), p.Name)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	want := p
+	want.Command = defaultString(strings.ToUpper(want.Command), "ALL")
+	want.Permissive = defaultString(strings.ToUpper(want.Permissive), "PERMISSIVE")
+	if len(want.Roles) == 0 {
+		want.Roles = []string{"public"}
+	}
+	want.Roles = sortedStrings(want.Roles)
+	if want.Using != "" || want.WithCheck != "" {
+		if want.Using, want.WithCheck, err = a.normalizePolicy(dbPolicy, want.Command, want.Using, want.WithCheck); err != nil {
+			assert.FailNow(a.T, err.Error())
+			return false
+		}
+	}
+	dbPolicy.TableName = p.TableName
+	if p.Schema == "" {
+		dbPolicy.Schema = ""
+	}
+	return assert.Equal(a.T, want, *dbPolicy, "%s: policy %s is not valid", p.TableName, p.Name)
+}
+
+func (a *DbAsserts) getRowSecurity(tableName string) (bool, bool, error) {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, table, err := a.resolveTable(tableName)
+	if err != nil {
+		return false, false, err
+	}
+	const query = `
+select c.relrowsecurity, c.relforcerowsecurity
+from pg_class c
+join pg_namespace n on n.oid = c.relnamespace
+where n.nspname = $1 and c.relname = $2`
+	var enabled, forced bool
+	err = a.Db.QueryRow(query, schema, table).Scan(&enabled, &forced)
+	switch {
+	case err == sql.ErrNoRows:
+		return false, false, fmt.Errorf("table %s not found", tableName)
+	case err != nil:
+		return false, false, err
+	}
+	return enabled, forced, nil
+}
+
+func (a *DbAsserts) getPolicy(tableName, name string) (*PolicyInfo, error) {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, table, err := a.resolveTable(tableName)
+	if err != nil {
+		return nil, err
+	}
+	const query = `
+select permissive, roles, cmd, coalesce(qual, ''), coalesce(with_check, '')
+from pg_policies
+where schemaname = $1 and tablename = $2 and policyname = $3`
+	p := PolicyInfo{
+		Schema:    schema,
+		TableName: table,
+		Name:      name,
+	}
+	err = a.Db.QueryRow(query, schema, table, name).Scan(&p.Permissive, pq.Array(&p.Roles), &p.Command, &p.Using, &p.WithCheck)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, fmt.Errorf("%s: policy %s not found", tableName, name)
+	case err != nil:
+		return nil, err
+	}
+	p.Roles = sortedStrings(p.Roles)
+	return &p, nil
+}
+
+// normalizePolicy has the database render the using and withCheck
+// expressions of a policy for command, by creating the policy on a scratch
+// copy of the table of p.
+func (a *DbAsserts) normalizePolicy(p *PolicyInfo, command, using, withCheck string) (string, string, error) {
+	create := fmt.Sprintf("create temp table %[1]s (like %[2]s.%[3]s); create policy %[1]s on %[1]s for %[4]s",
+		policyScratchName, pq.QuoteIdentifier(p.Schema), pq.QuoteIdentifier(p.TableName), command)
+	if using != "" {
+		create += fmt.Sprintf(" using (%s)", using)
+	}
+	if withCheck != "" {
+		create += fmt.Sprintf(" with check (%s)", withCheck)
+	}
+	query := fmt.Sprintf(`
+select coalesce(pg_get_expr(polqual, polrelid), ''), coalesce(pg_get_expr(polwithcheck, polrelid), '')
+from pg_policy
+where polrelid = 'pg_temp.%s'::regclass`, policyScratchName)
+	err := a.withRollback(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(create); err != nil {
+			return fmt.Errorf("invalid policy expressions: %w", err)
+		}
+		return tx.QueryRow(query).Scan(&using, &withCheck)
+	})
+	return using, withCheck, err
+}
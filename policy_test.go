@@ -0,0 +1,198 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"testing"
+)
+
+func TestDbAsserts_RowSecurity(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	cases := []struct {
+		name   string
+		assert func(a *DbAsserts) bool
+		want   bool
+	}{
+		{
+			name:   "enabled",
+			assert: func(a *DbAsserts) bool { return a.RowSecurityEnabled("test_rls_dbasserts") },
+			want:   true,
+		},
+		{
+			name:   "forced",
+			assert: func(a *DbAsserts) bool { return a.RowSecurityForced("test_rls_dbasserts") },
+			want:   true,
+		},
+		{
+			name:   "not-enabled",
+			assert: func(a *DbAsserts) bool { return a.RowSecurityEnabled("test_table_dbasserts") },
+			want:   false,
+		},
+		{
+			name:   "not-forced",
+			assert: func(a *DbAsserts) bool { return a.RowSecurityForced("test_table_dbasserts") },
+			want:   false,
+		},
+		{
+			name:   "bad-table",
+			assert: func(a *DbAsserts) bool { return a.RowSecurityEnabled("bad_table") },
+			want:   false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := tt.assert(a); got != tt.want {
+				t.Errorf("assert() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
+
+func TestDbAsserts_Policy(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	const tenant = "tenant_id = current_setting('app.tenant_id')"
+	cases := []struct {
+		name   string
+		policy PolicyInfo
+		want   bool
+	}{
+		{
+			name: "valid",
+			policy: PolicyInfo{
+				TableName: "test_rls_dbasserts",
+				Name:      "test_rls_tenant_policy",
+				Roles:     []string{"dbasserts_app"},
+				Using:     tenant,
+				WithCheck: tenant,
+			},
+			want: true,
+		},
+		{
+			name: "valid-qualified",
+			policy: PolicyInfo{
+				Schema:    "public",
+				TableName: "test_rls_dbasserts",
+				Name:      "test_rls_tenant_policy",
+				Command:   "all",
+				Roles:     []string{"dbasserts_app"},
+				Using:     "(tenant_id = current_setting('app.tenant_id'::text))",
+				WithCheck: tenant,
+			},
+			want: true,
+		},
+		{
+			name: "restrictive",
+			policy: PolicyInfo{
+				TableName:  "test_rls_dbasserts",
+				Name:       "test_rls_name_policy",
+				Command:    "select",
+				Permissive: "restrictive",
+				Using:      "name IS NOT NULL",
+			},
+			want: true,
+		},
+		{
+			name: "bad-command",
+			policy: PolicyInfo{
+				TableName:  "test_rls_dbasserts",
+				Name:       "test_rls_name_policy",
+				Permissive: "restrictive",
+				Using:      "name IS NOT NULL",
+			},
+			want: false,
+		},
+		{
+			name: "bad-roles",
+			policy: PolicyInfo{
+				TableName: "test_rls_dbasserts",
+				Name:      "test_rls_tenant_policy",
+				Using:     tenant,
+				WithCheck: tenant,
+			},
+			want: false,
+		},
+		{
+			name: "missing-with-check",
+			policy: PolicyInfo{
+				TableName: "test_rls_dbasserts",
+				Name:      "test_rls_tenant_policy",
+				Roles:     []string{"dbasserts_app"},
+				Using:     tenant,
+			},
+			want: false,
+		},
+		{
+			name: "bad-using",
+			policy: PolicyInfo{
+				TableName: "test_rls_dbasserts",
+				Name:      "test_rls_tenant_policy",
+				Roles:     []string{"dbasserts_app"},
+				Using:     "tenant_id = 'bad'",
+				WithCheck: tenant,
+			},
+			want: false,
+		},
+		{
+			name: "invalid-using",
+			policy: PolicyInfo{
+				TableName: "test_rls_dbasserts",
+				Name:      "test_rls_tenant_policy",
+				Roles:     []string{"dbasserts_app"},
+				Using:     "bad_column = 1",
+			},
+			want: false,
+		},
+		{
+			name: "bad-policy",
+			policy: PolicyInfo{
+				TableName: "test_rls_dbasserts",
+				Name:      "bad_policy",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := a.Policy(tt.policy); got != tt.want {
+				t.Errorf("Policy() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+// HasTablePrivilege asserts role has every privilege of privs, such as
+// SELECT or INSERT, on tableName, either granted directly or through the
+// roles it's a member of or PUBLIC.
+func (a *DbAsserts) HasTablePrivilege(role, tableName string, privs ...string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	return a.assertTablePrivileges(role, tableName, true, privs)
+}
+
+// NoTablePrivilege asserts role has none of the privileges privs on
+// tableName.
+func (a *DbAsserts) NoTablePrivilege(role, tableName string, privs ...string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	return a.assertTablePrivileges(role, tableName, false, privs)
+}
+
+// HasColumnPrivilege asserts role has every privilege of privs, such as
+// SELECT or UPDATE, on colName in tableName, granted on the column or on
+// the whole table.
+func (a *DbAsserts) HasColumnPrivilege(role, tableName, colName string, privs ...string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	return a.assertColumnPrivileges(role, tableName, colName, true, privs)
+}
+
+// NoColumnPrivilege asserts role has none of the privileges privs on
+// colName in tableName.
+func (a *DbAsserts) NoColumnPrivilege(role, tableName, colName string, privs ...string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	return a.assertColumnPrivileges(role, tableName, colName, false, privs)
+}
+
+// HasFunctionPrivilege asserts role can EXECUTE the function or procedure
+// name with the argument types args.
+func (a *DbAsserts) HasFunctionPrivilege(role, name string, args ...string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	return a.assertFunctionPrivilege(role, name, true, args)
+}
+
+// NoFunctionPrivilege asserts role can't EXECUTE the function or procedure
+// name with the argument types args.
+func (a *DbAsserts) NoFunctionPrivilege(role, name string, args ...string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	return a.assertFunctionPrivilege(role, name, false, args)
+}
+
+// HasSchemaPrivilege asserts role has every privilege of privs, USAGE or
+// CREATE, on schema.
+func (a *DbAsserts) HasSchemaPrivilege(role, schema string, privs ...string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	const query = `select has_schema_privilege($1, $2, $3)`
+	return a.assertPrivileges(fmt.Sprintf("schema %s", schema), role, true, privs, query, role, schema)
+}
+
+// NoSchemaPrivilege asserts role has none of the privileges privs on
+// schema.
+func (a *DbAsserts) NoSchemaPrivilege(role, schema string, privs ...string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	const query = `select has_schema_privilege($1, $2, $3)`
+	return a.assertPrivileges(fmt.Sprintf("schema %s", schema), role, false, privs, query, role, schema)
+}
+
+func (a *DbAsserts) assertTablePrivileges(role, tableName string, want bool, privs []string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, table, err := a.resolveTable(tableName)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	const query = `select has_table_privilege($1, $2, $3)`
+	qualified := pq.QuoteIdentifier(schema) + "." + pq.QuoteIdentifier(table)
+	return a.assertPrivileges(fmt.Sprintf("table %s", tableName), role, want, privs, query, role, qualified)
+}
+
+func (a *DbAsserts) assertColumnPrivileges(role, tableName, colName string, want bool, privs []string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, table, err := a.resolveTable(tableName)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	const query = `select has_column_privilege($1, $2, $3, $4)`
+	qualified := pq.QuoteIdentifier(schema) + "." + pq.QuoteIdentifier(table)
+	return a.assertPrivileges(fmt.Sprintf("column %s.%s", tableName, colName), role, want, privs, query, role, qualified, colName)
+}
+
+func (a *DbAsserts) assertFunctionPrivilege(role, name string, want bool, args []string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	_, signature, err := a.functionSignature(name, args...)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	const query = `select has_function_privilege($1, $2, $3)`
+	return a.assertPrivileges(fmt.Sprintf("function %s", signature), role, want, []string{"EXECUTE"}, query, role, signature)
+}
+
+// assertPrivileges asserts role has every privilege of privs on object
+// when want is true, or none of them otherwise. query checks a privilege,
+// which is passed after args.
+func (a *DbAsserts) assertPrivileges(object, role string, want bool, privs []string, query string, args ...interface{}) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	if len(privs) == 0 {
+		assert.FailNow(a.T, fmt.Sprintf("%s: no privileges to assert", object))
+		return false
+	}
+	var invalid []string
+	for _, priv := range privs {
+		var has bool
+		if err := a.Db.QueryRow(query, append(args, priv)...).Scan(&has); err != nil {
+			assert.FailNow(a.T, err.Error())
+			return false
+		}
+		if has != want {
+			invalid = append(invalid, strings.ToUpper(priv))
+		}
+	}
+	switch {
+	case len(invalid) == 0:
+		return true
+	case want:
+		assert.Fail(a.T, "missing privileges", "%s: %s doesn't have %s", object, role, strings.Join(invalid, ", "))
+	default:
+		assert.Fail(a.T, "unexpected privileges", "%s: %s has %s", object, role, strings.Join(invalid, ", "))
+	}
+	return false
+}
@@ -0,0 +1,144 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"testing"
+)
+
+func TestDbAsserts_Privilege(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	const role = "dbasserts_app"
+	cases := []struct {
+		name   string
+		assert func(a *DbAsserts) bool
+		want   bool
+	}{
+		{
+			name:   "table",
+			assert: func(a *DbAsserts) bool { return a.HasTablePrivilege(role, "test_table_dbasserts", "select", "INSERT") },
+			want:   true,
+		},
+		{
+			name:   "table-missing-privilege",
+			assert: func(a *DbAsserts) bool { return a.HasTablePrivilege(role, "test_table_dbasserts", "select", "delete") },
+			want:   false,
+		},
+		{
+			name:   "table-no-privilege",
+			assert: func(a *DbAsserts) bool { return a.NoTablePrivilege(role, "test_table_dbasserts", "delete", "truncate") },
+			want:   true,
+		},
+		{
+			name:   "table-no-privilege-granted",
+			assert: func(a *DbAsserts) bool { return a.NoTablePrivilege(role, "test_table_dbasserts", "insert") },
+			want:   false,
+		},
+		{
+			name:   "table-no-privileges",
+			assert: func(a *DbAsserts) bool { return a.HasTablePrivilege(role, "test_table_dbasserts") },
+			want:   false,
+		},
+		{
+			name:   "table-bad-role",
+			assert: func(a *DbAsserts) bool { return a.HasTablePrivilege("bad_role", "test_table_dbasserts", "select") },
+			want:   false,
+		},
+		{
+			name:   "table-bad-table",
+			assert: func(a *DbAsserts) bool { return a.HasTablePrivilege(role, "bad_table", "select") },
+			want:   false,
+		},
+		{
+			name: "column",
+			assert: func(a *DbAsserts) bool {
+				return a.HasColumnPrivilege(role, "test_table_dbasserts", "nullable", "update")
+			},
+			want: true,
+		},
+		{
+			name: "column-table-grant",
+			assert: func(a *DbAsserts) bool {
+				return a.HasColumnPrivilege(role, "test_table_dbasserts", "public_id", "select")
+			},
+			want: true,
+		},
+		{
+			name: "column-no-privilege",
+			assert: func(a *DbAsserts) bool {
+				return a.NoColumnPrivilege(role, "test_table_dbasserts", "public_id", "update")
+			},
+			want: true,
+		},
+		{
+			name: "column-no-privilege-granted",
+			assert: func(a *DbAsserts) bool {
+				return a.NoColumnPrivilege(role, "test_table_dbasserts", "nullable", "update")
+			},
+			want: false,
+		},
+		{
+			name:   "function",
+			assert: func(a *DbAsserts) bool { return a.HasFunctionPrivilege(role, "test_add_dbasserts", "int", "int") },
+			want:   true,
+		},
+		{
+			name: "function-no-privilege",
+			assert: func(a *DbAsserts) bool {
+				return a.NoFunctionPrivilege("pg_monitor", "test_add_dbasserts", "int", "int")
+			},
+			want: true,
+		},
+		{
+			name:   "function-no-privilege-granted",
+			assert: func(a *DbAsserts) bool { return a.NoFunctionPrivilege(role, "test_add_dbasserts", "int", "int") },
+			want:   false,
+		},
+		{
+			name:   "function-bad-function",
+			assert: func(a *DbAsserts) bool { return a.HasFunctionPrivilege(role, "test_add_dbasserts", "text") },
+			want:   false,
+		},
+		{
+			name:   "schema",
+			assert: func(a *DbAsserts) bool { return a.HasSchemaPrivilege(role, "dbasserts_audit", "usage") },
+			want:   true,
+		},
+		{
+			name:   "schema-missing-privilege",
+			assert: func(a *DbAsserts) bool { return a.HasSchemaPrivilege(role, "dbasserts_audit", "create") },
+			want:   false,
+		},
+		{
+			name:   "schema-no-privilege",
+			assert: func(a *DbAsserts) bool { return a.NoSchemaPrivilege(role, "dbasserts_audit", "create") },
+			want:   true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := tt.assert(a); got != tt.want {
+				t.Errorf("assert() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// tableSchemasQuery finds the schemas containing a table, view or
+// materialized view named $1.
+const tableSchemasQuery = `
+select n.nspname
+from pg_class c
+join pg_namespace n on n.oid = c.relnamespace
+where c.relname = $1
+	and c.relkind in ('r', 'p', 'v', 'm', 'f')
+	and n.nspname not in ('pg_catalog', 'information_schema')
+	and n.nspname not like 'pg_toast%'
+	and n.nspname not like 'pg_temp%'
+order by n.nspname`
+
+// splitName splits a possibly schema qualified name (schema.name) into its
+// schema and name. The schema is empty when the name is unqualified.
+func splitName(name string) (string, string) {
+	if i := strings.Index(name, "."); i >= 0 {
+		return name[:i], name[i+1:]
+	}
+	return "", name
+}
+
+// qualifyName returns name qualified with schema, unless schema is empty or
+// name is already qualified.
+func qualifyName(schema, name string) string {
+	if schema == "" || strings.Contains(name, ".") {
+		return name
+	}
+	return schema + "." + name
+}
+
+// resolveTable resolves tableName to its schema and unqualified name.
+func (a *DbAsserts) resolveTable(tableName string) (string, string, error) {
+	return a.resolveName("table", tableName, tableSchemasQuery)
+}
+
+// resolveName resolves name to its schema and unqualified name. A qualified
+// name's schema is used, otherwise the DbAsserts Schema is used when set.
+// schemaQuery is used to find the schemas containing an object named name,
+// and an error is returned when the schema doesn't contain it or, without a
+// schema, unless exactly one schema contains it.
+func (a *DbAsserts) resolveName(kind, name, schemaQuery string) (string, string, error) {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schemas, err := a.findSchemas(name, schemaQuery)
+	if err != nil {
+		return "", "", err
+	}
+	_, objName := splitName(name)
+	switch len(schemas) {
+	case 0:
+		return "", "", fmt.Errorf("%s %s not found", kind, name)
+	case 1:
+		return schemas[0], objName, nil
+	default:
+		return "", "", fmt.Errorf("%s %s is ambiguous, it exists in schemas %s: qualify the name or set DbAsserts.Schema",
+			kind, name, strings.Join(schemas, ", "))
+	}
+}
+
+// findSchemas returns the schemas containing an object named name, found
+// with schemaQuery. When name is qualified, or the DbAsserts Schema is set,
+// only that schema is returned when it contains the object.
+func (a *DbAsserts) findSchemas(name, schemaQuery string) ([]string, error) {
+	schema, name := splitName(name)
+	if schema == "" {
+		schema = a.Schema
+	}
+	schemas, err := a.queryStrings(schemaQuery, name)
+	if err != nil || schema == "" {
+		return schemas, err
+	}
+	for _, s := range schemas {
+		if s == schema {
+			return []string{s}, nil
+		}
+	}
+	return nil, nil
+}
+
+// queryStrings returns the first column of every row returned by query.
+func (a *DbAsserts) queryStrings(query string, args ...interface{}) ([]string, error) {
+	rows, err := a.Db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var values []string
+	for rows.Next() {
+		var v string
+		if err := rows.Scan(&v); err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// defaultString returns value, or def when value is empty.
+func defaultString(value, def string) string {
+	if value == "" {
+		return def
+	}
+	return value
+}
+
+// withRollback runs fn in a transaction which is always rolled back, so fn
+// can create scratch objects used to have the database render expressions.
+func (a *DbAsserts) withRollback(fn func(tx *sql.Tx) error) error {
+	tx, err := a.Db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	return fn(tx)
+}
+
+// upperStrings returns a copy of values in upper case.
+func upperStrings(values []string) []string {
+	if values == nil {
+		return nil
+	}
+	upper := make([]string, 0, len(values))
+	for _, v := range values {
+		upper = append(upper, strings.ToUpper(v))
+	}
+	return upper
+}
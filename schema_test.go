@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_splitName(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name       string
+		in         string
+		wantSchema string
+		wantName   string
+	}{
+		{
+			name:     "unqualified",
+			in:       "test_table_dbasserts",
+			wantName: "test_table_dbasserts",
+		},
+		{
+			name:       "qualified",
+			in:         "public.test_table_dbasserts",
+			wantSchema: "public",
+			wantName:   "test_table_dbasserts",
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			schema, name := splitName(tt.in)
+			assert.Equal(t, tt.wantSchema, schema)
+			assert.Equal(t, tt.wantName, name)
+		})
+	}
+}
+
+func Test_qualifyName(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "test_table_dbasserts", qualifyName("", "test_table_dbasserts"))
+	assert.Equal(t, "public.test_table_dbasserts", qualifyName("public", "test_table_dbasserts"))
+	assert.Equal(t, "audit.test_table_dbasserts", qualifyName("public", "audit.test_table_dbasserts"))
+}
+
+func TestDbAsserts_resolveTable(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	cases := []struct {
+		name       string
+		schema     string
+		tableName  string
+		wantSchema string
+		wantErr    string
+	}{
+		{
+			name:       "unqualified",
+			tableName:  "test_table_dbasserts",
+			wantSchema: "public",
+		},
+		{
+			name:       "qualified",
+			tableName:  "dbasserts_audit.test_multi_schema_dbasserts",
+			wantSchema: "dbasserts_audit",
+		},
+		{
+			name:       "default-schema",
+			schema:     "dbasserts_audit",
+			tableName:  "test_multi_schema_dbasserts",
+			wantSchema: "dbasserts_audit",
+		},
+		{
+			name:      "ambiguous",
+			tableName: "test_multi_schema_dbasserts",
+			wantErr:   "table test_multi_schema_dbasserts is ambiguous, it exists in schemas dbasserts_audit, public: qualify the name or set DbAsserts.Schema",
+		},
+		{
+			name:      "missing",
+			tableName: "bad_table",
+			wantErr:   "table bad_table not found",
+		},
+		{
+			name:      "qualified-missing",
+			tableName: "public.bad_table",
+			wantErr:   "table public.bad_table not found",
+		},
+		{
+			name:      "qualified-other-schema",
+			tableName: "dbasserts_audit.test_table_dbasserts",
+			wantErr:   "table dbasserts_audit.test_table_dbasserts not found",
+		},
+		{
+			name:      "default-schema-missing",
+			schema:    "dbasserts_audit",
+			tableName: "test_table_dbasserts",
+			wantErr:   "table test_table_dbasserts not found",
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			a := New(new(MockTesting), conn, "postgres")
+			a.Schema = tt.schema
+
+			schema, _, err := a.resolveTable(tt.tableName)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSchema, schema)
+		})
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// SequenceInfo defines a set of information about a sequence.
+type SequenceInfo struct {
+	// Schema of the sequence. When empty, the schema is resolved from Name
+	// or the DbAsserts Schema and isn't compared by Sequence.
+	Schema string
+
+	// Name of the sequence, optionally qualified with its schema
+	// (schema.sequence). When empty, the sequence is found using OwnedBy
+	// and its name isn't compared by Sequence.
+	Name string
+
+	// DataType of the sequence: smallint, integer or bigint. Empty is
+	// bigint.
+	DataType string
+
+	// Start value of the sequence. Zero is postgres' default start: 1, or
+	// -1 when Increment is negative.
+	Start int64
+
+	// Increment of the sequence. Zero is 1.
+	Increment int64
+
+	// IsCycle defines if the sequence cycles.
+	IsCycle bool
+
+	// OwnedBy is the column owning the sequence (table.column), optionally
+	// qualified with its schema (schema.table.column). Identity and serial
+	// columns own their sequences. Empty when the sequence isn't owned.
+	OwnedBy string
+}
+
+// sequenceSchemasQuery finds the schemas containing a sequence named $1.
+const sequenceSchemasQuery = `
+select n.nspname
+from pg_class c
+join pg_namespace n on n.oid = c.relnamespace
+where c.relname = $1
+	and c.relkind = 'S'
+	and n.nspname not in ('pg_catalog', 'information_schema')
+order by n.nspname`
+
+// Sequence asserts seq SequenceInfo is valid. The sequence is found by its
+// Name or, when Name is empty, by its OwnedBy column.
+func (a *DbAsserts) Sequence(seq SequenceInfo) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	name := qualifyName(seq.Schema, seq.Name)
+	if seq.Name == "" {
+		var err error
+		if name, err = a.getOwnedSequenceName(seq.OwnedBy); err != nil {
+			assert.FailNow(a.T, err.Error())
+			return false
+		}
+	}
+	dbSequence, err := a.getSequence(name)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	want := seq
+	want.DataType = defaultString(strings.ToLower(want.DataType), "bigint")
+	if want.Increment == 0 {
+		want.Increment = 1
+	}
+	if want.Start == 0 {
+		want.Start = 1
+		if want.Increment < 0 {
+			want.Start = -1
+		}
+	}
+	dbSequence.Name = seq.Name
+	if seq.Schema == "" {
+		dbSequence.Schema = ""
+	}
+	if strings.Count(want.OwnedBy, ".") < 2 {
+		_, dbSequence.OwnedBy = splitName(dbSequence.OwnedBy)
+	}
+	return assert.Equal(a.T, want, *dbSequence, "%s: sequence is not valid", defaultString(seq.Name, seq.OwnedBy))
+}
+
+// SequenceValue asserts the last value returned by nextval for the sequence
+// name is value.
+func (a *DbAsserts) SequenceValue(name string, value int64) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, seqName, err := a.resolveName("sequence", name, sequenceSchemasQuery)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	const query = `
+select last_value
+from pg_sequences
+where schemaname = $1 and sequencename = $2`
+	var lastValue sql.NullInt64
+	err = a.Db.QueryRow(query, schema, seqName).Scan(&lastValue)
+	switch {
+	case err == sql.ErrNoRows:
+		assert.FailNow(a.T, fmt.Sprintf("sequence %s not found", name))
+		return false
+	case err != nil:
+		assert.FailNow(a.T, err.Error())
+		return false
+	case !lastValue.Valid:
+		assert.Fail(a.T, "sequence not used", "%s: sequence has not been used", name)
+		return false
+	}
+	return assert.Equal(a.T, value, lastValue.Int64, "%s: sequence value is not valid", name)
+}
+
+func (a *DbAsserts) getSequence(name string) (*SequenceInfo, error) {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, seqName, err := a.resolveName("sequence", name, sequenceSchemasQuery)
+	if err != nil {
+		return nil, err
+	}
+	const query = `
+select
+	format_type(s.seqtypid, null),
+	s.seqstart,
+	s.seqincrement,
+	s.seqcycle,
+	coalesce((
+		select tn.nspname || '.' || tc.relname || '.' || a.attname
+		from pg_depend d
+		join pg_class tc on tc.oid = d.refobjid
+		join pg_namespace tn on tn.oid = tc.relnamespace
+		join pg_attribute a on a.attrelid = d.refobjid and a.attnum = d.refobjsubid
+		where d.classid = 'pg_class'::regclass
+			and d.objid = s.seqrelid
+			and d.refclassid = 'pg_class'::regclass
+			and d.deptype in ('a', 'i')
+	), '')
+from pg_sequence s
+join pg_class c on c.oid = s.seqrelid
+join pg_namespace n on n.oid = c.relnamespace
+where n.nspname = $1 and c.relname = $2`
+	seq := SequenceInfo{
+		Schema: schema,
+		Name:   name,
+	}
+	err = a.Db.QueryRow(query, schema, seqName).Scan(&seq.DataType, &seq.Start, &seq.Increment, &seq.IsCycle, &seq.OwnedBy)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, fmt.Errorf("sequence %s not found", name)
+	case err != nil:
+		return nil, err
+	}
+	return &seq, nil
+}
+
+// getOwnedSequenceName returns the qualified name of the sequence owned by
+// ownedBy, a column of a table (table.column or schema.table.column).
+func (a *DbAsserts) getOwnedSequenceName(ownedBy string) (string, error) {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	i := strings.LastIndex(ownedBy, ".")
+	if i < 0 {
+		return "", fmt.Errorf("owned by %q is not a table.column", ownedBy)
+	}
+	schema, table, err := a.resolveTable(ownedBy[:i])
+	if err != nil {
+		return "", err
+	}
+	const query = `
+select n.nspname || '.' || c.relname
+from pg_depend d
+join pg_class c on c.oid = d.objid
+join pg_namespace n on n.oid = c.relnamespace
+join pg_class tc on tc.oid = d.refobjid
+join pg_namespace tn on tn.oid = tc.relnamespace
+join pg_attribute a on a.attrelid = d.refobjid and a.attnum = d.refobjsubid
+where d.classid = 'pg_class'::regclass
+	and d.refclassid = 'pg_class'::regclass
+	and d.deptype in ('a', 'i')
+	and c.relkind = 'S'
+	and tn.nspname = $1
+	and tc.relname = $2
+	and a.attname = $3`
+	var name string
+	err = a.Db.QueryRow(query, schema, table, ownedBy[i+1:]).Scan(&name)
+	switch {
+	case err == sql.ErrNoRows:
+		return "", fmt.Errorf("no sequence owned by %s", ownedBy)
+	case err != nil:
+		return "", err
+	}
+	return name, nil
+}
@@ -0,0 +1,212 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"testing"
+)
+
+func TestDbAsserts_Sequence(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	cases := []struct {
+		name string
+		seq  SequenceInfo
+		want bool
+	}{
+		{
+			name: "sequence",
+			seq: SequenceInfo{
+				Name:      "test_seq_dbasserts",
+				DataType:  "integer",
+				Start:     100,
+				Increment: 10,
+				IsCycle:   true,
+			},
+			want: true,
+		},
+		{
+			name: "qualified",
+			seq: SequenceInfo{
+				Name:      "public.test_seq_dbasserts",
+				DataType:  "integer",
+				Start:     100,
+				Increment: 10,
+				IsCycle:   true,
+			},
+			want: true,
+		},
+		{
+			name: "identity",
+			seq: SequenceInfo{
+				Start:   1,
+				OwnedBy: "test_table_dbasserts.id",
+			},
+			want: true,
+		},
+		{
+			name: "default-start",
+			seq: SequenceInfo{
+				DataType: "integer",
+				OwnedBy:  "test_serial_dbasserts.id",
+			},
+			want: true,
+		},
+		{
+			name: "serial",
+			seq: SequenceInfo{
+				Name:     "test_serial_dbasserts_id_seq",
+				DataType: "integer",
+				Start:    1,
+				OwnedBy:  "public.test_serial_dbasserts.id",
+			},
+			want: true,
+		},
+		{
+			name: "bad-data-type",
+			seq: SequenceInfo{
+				Name:      "test_seq_dbasserts",
+				Start:     100,
+				Increment: 10,
+				IsCycle:   true,
+			},
+			want: false,
+		},
+		{
+			name: "bad-increment",
+			seq: SequenceInfo{
+				Name:      "test_seq_dbasserts",
+				DataType:  "integer",
+				Start:     100,
+				Increment: 1,
+				IsCycle:   true,
+			},
+			want: false,
+		},
+		{
+			name: "bad-owned-by",
+			seq: SequenceInfo{
+				Name:      "test_seq_dbasserts",
+				DataType:  "integer",
+				Start:     100,
+				Increment: 10,
+				IsCycle:   true,
+				OwnedBy:   "test_table_dbasserts.id",
+			},
+			want: false,
+		},
+		{
+			name: "not-owned",
+			seq: SequenceInfo{
+				OwnedBy: "test_table_dbasserts.type_int",
+			},
+			want: false,
+		},
+		{
+			name: "bad-sequence",
+			seq: SequenceInfo{
+				Name: "bad_sequence",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := a.Sequence(tt.seq); got != tt.want {
+				t.Errorf("Sequence() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
+
+func TestDbAsserts_SequenceValue(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	for i := 0; i < 2; i++ {
+		if _, err := conn.Exec("insert into test_serial_dbasserts (name) values ('name')"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// evaluating a volatile default mustn't advance the sequence.
+	evaluate := New(new(MockTesting), conn, "postgres")
+	evaluate.EvaluateDefaults = true
+	evaluate.Column(ColumnInfo{
+		TableName: "test_serial_dbasserts",
+		Name:      "id",
+		Default:   "nextval('test_serial_dbasserts_id_seq') + 0",
+		Type:      "integer",
+	})
+	cases := []struct {
+		name    string
+		seqName string
+		value   int64
+		want    bool
+	}{
+		{
+			name:    "used",
+			seqName: "test_serial_dbasserts_id_seq",
+			value:   2,
+			want:    true,
+		},
+		{
+			name:    "bad-value",
+			seqName: "test_serial_dbasserts_id_seq",
+			value:   1,
+			want:    false,
+		},
+		{
+			name:    "not-used",
+			seqName: "test_seq_dbasserts",
+			value:   100,
+			want:    false,
+		},
+		{
+			name:    "bad-sequence",
+			seqName: "bad_sequence",
+			value:   1,
+			want:    false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := a.SequenceValue(tt.seqName, tt.value); got != tt.want {
+				t.Errorf("SequenceValue() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
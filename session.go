@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+// Session provides data assertions run as a database role, with session
+// settings such as app.tenant_id, to verify row level security policies
+// and grants behave as expected. Every assertion runs in its own
+// transaction on the DbAsserts Db, after SET LOCAL ROLE, and is rolled back,
+// so neither its role, settings or changes leak to other assertions or
+// connections.
+type Session struct {
+	a        *DbAsserts
+	role     string
+	settings map[string]string
+}
+
+// AsRole returns a Session running data assertions as role.
+func (a *DbAsserts) AsRole(role string) *Session {
+	return &Session{
+		a:    a,
+		role: role,
+	}
+}
+
+// WithSetting returns a copy of the Session which also sets the setting
+// name to value, with set_config, before every assertion.
+func (s *Session) WithSetting(name, value string) *Session {
+	settings := make(map[string]string, len(s.settings)+1)
+	for k, v := range s.settings {
+		settings[k] = v
+	}
+	settings[name] = value
+	return &Session{
+		a:        s.a,
+		role:     s.role,
+		settings: settings,
+	}
+}
+
+// RowCount asserts count rows of tableName are visible to the Session.
+func (s *Session) RowCount(tableName string, count int) bool {
+	if h, ok := s.a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, table, err := s.a.resolveTable(tableName)
+	if err != nil {
+		assert.FailNow(s.a.T, err.Error())
+		return false
+	}
+	query := fmt.Sprintf("select count(*) from %s.%s", pq.QuoteIdentifier(schema), pq.QuoteIdentifier(table))
+	var dbCount int
+	err = s.withSession(func(tx *sql.Tx) error {
+		return tx.QueryRow(query).Scan(&dbCount)
+	})
+	if err != nil {
+		assert.FailNow(s.a.T, err.Error())
+		return false
+	}
+	return assert.Equal(s.a.T, count, dbCount, "%s: row count as %s is not valid", tableName, s.role)
+}
+
+// ExecSucceeds asserts query, run with args, succeeds for the Session.
+func (s *Session) ExecSucceeds(query string, args ...interface{}) bool {
+	if h, ok := s.a.T.(THelper); ok {
+		h.Helper()
+	}
+	var execErr error
+	err := s.withSession(func(tx *sql.Tx) error {
+		_, execErr = tx.Exec(query, args...)
+		return nil
+	})
+	if err != nil {
+		assert.FailNow(s.a.T, err.Error())
+		return false
+	}
+	if execErr == nil {
+		return true
+	}
+	assert.Fail(s.a.T, "exec failed", "%q as %s failed: %s", query, s.role, execErr)
+	return false
+}
+
+// ExecFails asserts query, run with args, fails for the Session with the
+// SQLSTATE sqlState, for example 42501 (insufficient_privilege) because of
+// a missing grant or a policy's WITH CHECK expression. Failing with another
+// SQLSTATE, such as a syntax error or an undefined table, doesn't satisfy
+// the assertion.
+func (s *Session) ExecFails(sqlState, query string, args ...interface{}) bool {
+	if h, ok := s.a.T.(THelper); ok {
+		h.Helper()
+	}
+	var execErr error
+	err := s.withSession(func(tx *sql.Tx) error {
+		_, execErr = tx.Exec(query, args...)
+		return nil
+	})
+	if err != nil {
+		assert.FailNow(s.a.T, err.Error())
+		return false
+	}
+	if execErr == nil {
+		assert.Fail(s.a.T, "exec succeeded", "%q as %s succeeded", query, s.role)
+		return false
+	}
+	var stateErr interface{ SQLState() string }
+	if !errors.As(execErr, &stateErr) || stateErr.SQLState() != sqlState {
+		assert.Fail(s.a.T, "exec failed with another error", "%q as %s failed with %s not SQLSTATE %s", query, s.role, execErr, sqlState)
+		return false
+	}
+	if l, ok := s.a.T.(TLogger); ok {
+		l.Logf("%q as %s failed: %s", query, s.role, execErr)
+	}
+	return true
+}
+
+// withSession runs fn in a transaction which is always rolled back, after
+// setting the Session's role and settings.
+func (s *Session) withSession(fn func(tx *sql.Tx) error) error {
+	return s.a.withRollback(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(fmt.Sprintf("set local role %s", pq.QuoteIdentifier(s.role))); err != nil {
+			return fmt.Errorf("unable to set role %s: %w", s.role, err)
+		}
+		names := make([]string, 0, len(s.settings))
+		for name := range s.settings {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if _, err := tx.Exec(`select set_config($1, $2, true)`, name, s.settings[name]); err != nil {
+				return fmt.Errorf("unable to set %s: %w", name, err)
+			}
+		}
+		return fn(tx)
+	})
+}
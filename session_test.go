@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"testing"
+)
+
+func TestSession(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	const insert = `insert into test_rls_dbasserts (id, tenant_id, name) values ($1, $2, $3)`
+	cases := []struct {
+		name   string
+		assert func(a *DbAsserts) bool
+		want   bool
+	}{
+		{
+			name: "row-count",
+			assert: func(a *DbAsserts) bool {
+				return a.AsRole("dbasserts_app").WithSetting("app.tenant_id", "tenant_1").RowCount("test_rls_dbasserts", 1)
+			},
+			want: true,
+		},
+		{
+			name: "row-count-other-tenant",
+			assert: func(a *DbAsserts) bool {
+				return a.AsRole("dbasserts_app").WithSetting("app.tenant_id", "tenant_3").RowCount("test_rls_dbasserts", 0)
+			},
+			want: true,
+		},
+		{
+			name: "bad-row-count",
+			assert: func(a *DbAsserts) bool {
+				return a.AsRole("dbasserts_app").WithSetting("app.tenant_id", "tenant_1").RowCount("test_rls_dbasserts", 2)
+			},
+			want: false,
+		},
+		{
+			name: "row-count-bad-role",
+			assert: func(a *DbAsserts) bool {
+				return a.AsRole("bad_role").RowCount("test_rls_dbasserts", 0)
+			},
+			want: false,
+		},
+		{
+			name: "exec-succeeds",
+			assert: func(a *DbAsserts) bool {
+				return a.AsRole("dbasserts_app").WithSetting("app.tenant_id", "tenant_1").ExecSucceeds(insert, 4, "tenant_1", "name_4")
+			},
+			want: true,
+		},
+		{
+			name: "exec-succeeds-with-check",
+			assert: func(a *DbAsserts) bool {
+				return a.AsRole("dbasserts_app").WithSetting("app.tenant_id", "tenant_1").ExecSucceeds(insert, 4, "tenant_2", "name_4")
+			},
+			want: false,
+		},
+		{
+			name: "exec-fails-with-check",
+			assert: func(a *DbAsserts) bool {
+				return a.AsRole("dbasserts_app").WithSetting("app.tenant_id", "tenant_1").ExecFails("42501", insert, 4, "tenant_2", "name_4")
+			},
+			want: true,
+		},
+		{
+			name: "exec-fails-grant",
+			assert: func(a *DbAsserts) bool {
+				return a.AsRole("dbasserts_app").WithSetting("app.tenant_id", "tenant_1").ExecFails("42501", `delete from test_rls_dbasserts`)
+			},
+			want: true,
+		},
+		{
+			name: "exec-fails-succeeded",
+			assert: func(a *DbAsserts) bool {
+				return a.AsRole("dbasserts_app").WithSetting("app.tenant_id", "tenant_1").ExecFails("42501", insert, 4, "tenant_1", "name_4")
+			},
+			want: false,
+		},
+		{
+			name: "exec-fails-syntax-error",
+			assert: func(a *DbAsserts) bool {
+				return a.AsRole("dbasserts_app").WithSetting("app.tenant_id", "tenant_1").ExecFails("42501", `delet from test_rls_dbasserts`)
+			},
+			want: false,
+		},
+		{
+			name: "exec-fails-undefined-table",
+			assert: func(a *DbAsserts) bool {
+				return a.AsRole("dbasserts_app").WithSetting("app.tenant_id", "tenant_1").ExecFails("42501", `delete from bad_table`)
+			},
+			want: false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := tt.assert(a); got != tt.want {
+				t.Errorf("assert() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
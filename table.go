@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Table asserts tableName has exactly the columns cols, in any order. Each
+// column is compared like Column, and every missing, unexpected and invalid
+// column is reported in a single failure.
+func (a *DbAsserts) Table(tableName string, cols ...ColumnInfo) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	return a.assertTable(tableName, false, cols)
+}
+
+// TableInOrder asserts tableName has exactly the columns cols, in their
+// ordinal order. Columns are compared like Table.
+func (a *DbAsserts) TableInOrder(tableName string, cols ...ColumnInfo) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	return a.assertTable(tableName, true, cols)
+}
+
+func (a *DbAsserts) assertTable(tableName string, inOrder bool, cols []ColumnInfo) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	dbColumns, err := a.getTableColumns(tableName)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	byName := make(map[string]*ColumnInfo, len(dbColumns))
+	for i := range dbColumns {
+		byName[dbColumns[i].Name] = &dbColumns[i]
+	}
+	var problems, missing, order []string
+	expected := make(map[string]bool, len(cols))
+	for _, c := range cols {
+		expected[c.Name] = true
+		dbColumn, ok := byName[c.Name]
+		if !ok {
+			missing = append(missing, c.Name)
+			continue
+		}
+		order = append(order, c.Name)
+		c.TableName = tableName
+		if c, err = a.wantColumn(c, dbColumn); err != nil {
+			assert.FailNow(a.T, err.Error())
+			return false
+		}
+		if got := comparableColumn(c, dbColumn); c != *got {
+			problems = append(problems, fmt.Sprintf("column %s is not valid: %+v in the db column %+v", c.Name, c, *got))
+		}
+	}
+	var unexpected, dbOrder []string
+	for _, c := range dbColumns {
+		if !expected[c.Name] {
+			unexpected = append(unexpected, c.Name)
+			continue
+		}
+		dbOrder = append(dbOrder, c.Name)
+	}
+	if len(missing) > 0 {
+		problems = append(problems, fmt.Sprintf("missing columns: %s", strings.Join(missing, ", ")))
+	}
+	if len(unexpected) > 0 {
+		problems = append(problems, fmt.Sprintf("unexpected columns: %s", strings.Join(unexpected, ", ")))
+	}
+	if inOrder && strings.Join(order, ",") != strings.Join(dbOrder, ",") {
+		problems = append(problems, fmt.Sprintf("columns are in the order %s not %s", strings.Join(dbOrder, ", "), strings.Join(order, ", ")))
+	}
+	if len(problems) == 0 {
+		return true
+	}
+	assert.Fail(a.T, "invalid table", "%s: table columns are not valid:\n%s", tableName, strings.Join(problems, "\n"))
+	return false
+}
@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"testing"
+)
+
+func TestDbAsserts_Table(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	var (
+		id       = ColumnInfo{Name: "id", Type: "bigint"}
+		publicId = ColumnInfo{Name: "public_id", Type: "text", DomainName: "dbasserts_public_id"}
+		nullable = ColumnInfo{Name: "nullable", Type: "text", IsNullable: true}
+		typeInt  = ColumnInfo{Name: "type_int", Type: "integer", IsNullable: true}
+	)
+	cases := []struct {
+		name      string
+		tableName string
+		cols      []ColumnInfo
+		inOrder   bool
+		want      bool
+	}{
+		{
+			name:      "valid",
+			tableName: "test_table_dbasserts",
+			cols:      []ColumnInfo{typeInt, id, nullable, publicId},
+			want:      true,
+		},
+		{
+			name:      "valid-in-order",
+			tableName: "test_table_dbasserts",
+			cols:      []ColumnInfo{id, publicId, nullable, typeInt},
+			inOrder:   true,
+			want:      true,
+		},
+		{
+			name:      "bad-order",
+			tableName: "test_table_dbasserts",
+			cols:      []ColumnInfo{typeInt, id, nullable, publicId},
+			inOrder:   true,
+			want:      false,
+		},
+		{
+			name:      "identity",
+			tableName: "test_table_dbasserts",
+			cols: []ColumnInfo{
+				{Name: "id", Type: "bigint", Identity: IdentityInfo{Generation: "always", Start: 1, Increment: 1}},
+				publicId, nullable, typeInt,
+			},
+			want: true,
+		},
+		{
+			name:      "qualified",
+			tableName: "dbasserts_audit.test_multi_schema_dbasserts",
+			cols: []ColumnInfo{
+				{Name: "id", Type: "bigint"},
+				{Name: "name", Type: "text"},
+			},
+			want: true,
+		},
+		{
+			name:      "missing",
+			tableName: "test_table_dbasserts",
+			cols:      []ColumnInfo{id, publicId, nullable, typeInt, {Name: "bad_column", Type: "text"}},
+			want:      false,
+		},
+		{
+			name:      "unexpected",
+			tableName: "test_table_dbasserts",
+			cols:      []ColumnInfo{id, publicId, nullable},
+			want:      false,
+		},
+		{
+			name:      "mismatched",
+			tableName: "test_table_dbasserts",
+			cols:      []ColumnInfo{id, publicId, nullable, {Name: "type_int", Type: "bigint", IsNullable: true}},
+			want:      false,
+		},
+		{
+			name:      "bad-table",
+			tableName: "bad_table",
+			cols:      []ColumnInfo{id},
+			want:      false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			assertTable := a.Table
+			if tt.inOrder {
+				assertTable = a.TableInOrder
+			}
+			if got := assertTable(tt.tableName, tt.cols...); got != tt.want {
+				t.Errorf("Table() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
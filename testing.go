@@ -22,6 +22,7 @@ var (
 type MockTesting struct {
 	err bool
 	msg string
+	log string
 }
 
 // HasError returns if the MockTesting has an error for a previous test.
@@ -41,6 +42,16 @@ func (m *MockTesting) Errorf(format string, args ...interface{}) {
 	m.err = true
 }
 
+// Logf provides a mock Logf function.
+func (m *MockTesting) Logf(format string, args ...interface{}) {
+	m.log = fmt.Sprintf(format, args...)
+}
+
+// LogMsg returns the last msg logged with Logf.
+func (m *MockTesting) LogMsg() string {
+	return m.log
+}
+
 // FailNow provides a mock FailNow function.
 func (m *MockTesting) FailNow() {
 	m.err = true
@@ -50,6 +61,7 @@ func (m *MockTesting) FailNow() {
 func (m *MockTesting) Reset() {
 	m.err = false
 	m.msg = ""
+	m.log = ""
 }
 
 // AssertNoError asserts that the MockTesting has no current error.
@@ -69,8 +81,10 @@ func (m *MockTesting) AssertError(t *testing.T) {
 }
 
 // TestSetup sets up the testing env, including starting a docker container
-// running the db dialect and initializing the test database schema.
-func TestSetup(t *testing.T, dialect string) (func() error, *sql.DB, string) {
+// running the db dialect, creating extensions and initializing the test
+// database schema. The extensions must be available in the container, such
+// as the postgres contrib extensions pgcrypto, citext and pg_trgm.
+func TestSetup(t *testing.T, dialect string, extensions ...string) (func() error, *sql.DB, string) {
 	cleanup, url, _, err := StartDbInDocker(dialect)
 	if err != nil {
 		t.Fatal(err)
@@ -79,6 +93,9 @@ func TestSetup(t *testing.T, dialect string) (func() error, *sql.DB, string) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	if err := createExtensions(db, extensions...); err != nil {
+		t.Fatal(err)
+	}
 	if err := initStore(t, db); err != nil {
 		t.Fatal(err)
 	}
@@ -104,6 +121,256 @@ create table if not exists test_table_dbasserts (
 );
 comment on table test_table_dbasserts is
 'dbasserts test table'
+`
+		createSchemas = `
+create schema dbasserts_audit;
+create table if not exists public.test_multi_schema_dbasserts (
+  id bigint primary key,
+  name text
+);
+create table if not exists dbasserts_audit.test_multi_schema_dbasserts (
+  id bigint primary key,
+  name text not null
+);
+`
+		createConstraints = `
+create table if not exists test_parent_dbasserts (
+  tenant_id text not null,
+  id bigint not null,
+  primary key(tenant_id, id)
+);
+create table if not exists test_no_pk_dbasserts (
+  name text
+);
+create table if not exists test_child_dbasserts (
+  id bigint generated always as identity primary key,
+  tenant_id text not null,
+  parent_id bigint,
+  owner_id bigint references test_table_dbasserts(id),
+  constraint test_child_parent_fkey
+    foreign key (tenant_id, parent_id)
+    references test_parent_dbasserts(tenant_id, id)
+    match full
+    on delete cascade
+    on update restrict
+    deferrable initially deferred
+);
+create table if not exists test_unique_dbasserts (
+  id bigint primary key,
+  tenant_id text,
+  name text,
+  email text,
+  code text,
+  constraint test_unique_tenant_name_uq unique (tenant_id, name)
+);
+create unique index test_unique_email_idx
+  on test_unique_dbasserts (email) nulls not distinct;
+create unique index test_unique_code_idx
+  on test_unique_dbasserts (code) where code is not null;
+create table if not exists test_check_dbasserts (
+  id bigint primary key,
+  amount int,
+  checksum text,
+  constraint test_check_amount_positive check (amount > 0),
+  constraint test_check_checksum_not_empty check (checksum <> '')
+);
+`
+		createIndexes = `
+create table if not exists test_index_dbasserts (
+  id bigint primary key,
+  tenant_id text,
+  name text,
+  email text,
+  tags text[],
+  create_time timestamp
+);
+create index test_index_tenant_name_idx
+  on test_index_dbasserts (tenant_id, name) include (email)
+  where name is not null;
+create unique index test_index_lower_email_idx
+  on test_index_dbasserts (lower(email));
+create index test_index_tags_idx
+  on test_index_dbasserts using gin (tags);
+create index test_index_create_time_idx
+  on test_index_dbasserts using brin (create_time);
+create index test_index_name_not_empty_idx
+  on test_index_dbasserts (name) where name <> '';
+`
+		createTriggers = `
+create table if not exists test_trigger_dbasserts (
+  id bigint primary key,
+  name text,
+  update_time timestamp
+);
+create or replace function test_update_time_dbasserts()
+  returns trigger
+as $$
+begin
+  new.update_time = now();
+  return new;
+end;
+$$ language plpgsql;
+create trigger test_update_time_trigger
+  before update of name on test_trigger_dbasserts
+  for each row execute function test_update_time_dbasserts();
+create trigger test_insert_delete_trigger
+  after insert or delete on test_trigger_dbasserts
+  for each statement execute function test_update_time_dbasserts();
+alter table test_trigger_dbasserts
+  disable trigger test_insert_delete_trigger;
+`
+		createFunctions = `
+create or replace function test_add_dbasserts(a int, b int)
+  returns int
+  language sql
+  immutable
+  security definer
+  set search_path = pg_catalog, public
+as 'select a + b';
+create or replace procedure test_proc_dbasserts(name text)
+  language sql
+as 'select 1';
+`
+		createViews = `
+create view test_view_dbasserts
+  with (security_barrier, security_invoker = on)
+as
+  select id, public_id, nullable
+  from test_table_dbasserts
+  where type_int is not null;
+create materialized view test_matview_dbasserts as
+  select id, public_id
+  from test_table_dbasserts;
+create unique index test_matview_id_idx
+  on test_matview_dbasserts (id);
+create materialized view test_matview_no_idx_dbasserts as
+  select id
+  from test_table_dbasserts;
+`
+		createSequences = `
+create sequence test_seq_dbasserts
+  as integer
+  start with 100
+  increment by 10
+  cycle;
+create table if not exists test_serial_dbasserts (
+  id serial primary key,
+  name text
+);
+`
+		createTypes = `
+create type test_status_dbasserts as enum (
+  'pending',
+  'active',
+  'closed'
+);
+create domain test_code_dbasserts as varchar(32)
+  collate "C"
+  default 'none'
+  not null
+  constraint test_code_not_empty check (length(value) > 0)
+  constraint test_code_lower check (value = lower(value));
+create type test_address_dbasserts as (
+  street text,
+  city varchar(64),
+  status test_status_dbasserts
+);
+create table if not exists test_type_dbasserts (
+  id bigint primary key,
+  status test_status_dbasserts not null,
+  name text,
+  address test_address_dbasserts
+);
+create table if not exists test_column_types_dbasserts (
+  code varchar(32) collate "C",
+  amount numeric(10, 2),
+  tags text[],
+  counts int[],
+  create_time timestamp(3),
+  name text,
+  name_length int generated always as (length(name)) stored,
+  status text default 'new',
+  quantity int default 2,
+  update_time timestamptz default current_timestamp
+);
+create view test_column_types_view_dbasserts as
+  select code, amount, tags
+  from test_column_types_dbasserts;
+`
+		createComments = `
+comment on column test_table_dbasserts.public_id is
+'dbasserts test public id';
+comment on function test_add_dbasserts(int, int) is
+'dbasserts test function';
+create table if not exists test_documented_dbasserts (
+  id bigint primary key,
+  name text
+);
+comment on table test_documented_dbasserts is
+'dbasserts documented table';
+comment on column test_documented_dbasserts.id is
+'dbasserts documented id';
+comment on column test_documented_dbasserts.name is
+'dbasserts documented name';
+`
+		createPrivileges = `
+create role dbasserts_app;
+grant select, insert on test_table_dbasserts to dbasserts_app;
+grant update (nullable) on test_table_dbasserts to dbasserts_app;
+grant usage on schema dbasserts_audit to dbasserts_app;
+revoke execute on function test_add_dbasserts(int, int) from public;
+grant execute on function test_add_dbasserts(int, int) to dbasserts_app;
+`
+		createPolicies = `
+create table if not exists test_rls_dbasserts (
+  id bigint primary key,
+  tenant_id text not null,
+  name text
+);
+alter table test_rls_dbasserts enable row level security;
+alter table test_rls_dbasserts force row level security;
+grant select, insert on test_rls_dbasserts to dbasserts_app;
+create policy test_rls_tenant_policy on test_rls_dbasserts
+  to dbasserts_app
+  using (tenant_id = current_setting('app.tenant_id'))
+  with check (tenant_id = current_setting('app.tenant_id'));
+create policy test_rls_name_policy on test_rls_dbasserts
+  as restrictive
+  for select
+  using (name is not null);
+insert into test_rls_dbasserts (id, tenant_id, name) values
+  (1, 'tenant_1', 'name_1'),
+  (2, 'tenant_1', null),
+  (3, 'tenant_2', 'name_3');
+`
+		createPartitions = `
+create table if not exists test_event_dbasserts (
+  id bigint not null,
+  tenant_id text not null,
+  create_time timestamp not null
+) partition by range (create_time);
+create table if not exists test_event_2024_01_dbasserts partition of test_event_dbasserts
+  for values from ('2024-01-01') to ('2024-02-01');
+create table if not exists test_event_2024_02_dbasserts partition of test_event_dbasserts
+  for values from ('2024-02-01') to ('2024-03-01');
+create table if not exists test_event_default_dbasserts partition of test_event_dbasserts
+  default;
+create table if not exists test_region_dbasserts (
+  id bigint not null,
+  region text not null
+) partition by list (lower(region));
+create table if not exists test_region_eu_dbasserts partition of test_region_dbasserts
+  for values in ('eu', 'uk');
+create table if not exists test_daily_event_dbasserts (
+  id bigint not null,
+  create_time timestamp not null
+) partition by range ((create_time::date));
+create table if not exists test_hash_dbasserts (
+  id bigint not null,
+  tenant_id text not null
+) partition by hash (tenant_id, id);
+create table if not exists test_hash_0_dbasserts partition of test_hash_dbasserts
+  for values with (modulus 2, remainder 0);
 `
 	)
 	if _, err := db.Exec(createDomainType); err != nil {
@@ -112,6 +379,42 @@ comment on table test_table_dbasserts is
 	if _, err := db.Exec(createTable); err != nil {
 		return err
 	}
+	if _, err := db.Exec(createSchemas); err != nil {
+		return err
+	}
+	if _, err := db.Exec(createConstraints); err != nil {
+		return err
+	}
+	if _, err := db.Exec(createIndexes); err != nil {
+		return err
+	}
+	if _, err := db.Exec(createTriggers); err != nil {
+		return err
+	}
+	if _, err := db.Exec(createFunctions); err != nil {
+		return err
+	}
+	if _, err := db.Exec(createViews); err != nil {
+		return err
+	}
+	if _, err := db.Exec(createSequences); err != nil {
+		return err
+	}
+	if _, err := db.Exec(createTypes); err != nil {
+		return err
+	}
+	if _, err := db.Exec(createComments); err != nil {
+		return err
+	}
+	if _, err := db.Exec(createPrivileges); err != nil {
+		return err
+	}
+	if _, err := db.Exec(createPolicies); err != nil {
+		return err
+	}
+	if _, err := db.Exec(createPartitions); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -0,0 +1,166 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+// TriggerInfo defines a set of information about a trigger.
+type TriggerInfo struct {
+	// Schema of the trigger's table. When empty, the schema is resolved
+	// from TableName or the DbAsserts Schema and isn't compared by Trigger.
+	Schema string
+
+	// TableName for the trigger, optionally qualified with its schema
+	// (schema.table).
+	TableName string
+
+	// Name of the trigger.
+	Name string
+
+	// Timing of the trigger: BEFORE, AFTER or INSTEAD OF.
+	Timing string
+
+	// Events firing the trigger: INSERT, UPDATE, DELETE or TRUNCATE, in any
+	// order.
+	Events []string
+
+	// UpdateColumns are the columns of an UPDATE OF event, in order.
+	UpdateColumns []string
+
+	// Level of the trigger: ROW or STATEMENT. Empty is STATEMENT.
+	Level string
+
+	// Enabled state of the trigger: ENABLED, DISABLED, REPLICA or ALWAYS.
+	// Empty is ENABLED.
+	Enabled string
+
+	// Function executed by the trigger, optionally qualified with its
+	// schema (schema.function).
+	Function string
+}
+
+// pg_trigger tgtype bits.
+const (
+	triggerTypeRow      = 1 << 0
+	triggerTypeBefore   = 1 << 1
+	triggerTypeInsert   = 1 << 2
+	triggerTypeDelete   = 1 << 3
+	triggerTypeUpdate   = 1 << 4
+	triggerTypeTruncate = 1 << 5
+	triggerTypeInstead  = 1 << 6
+)
+
+var triggerEnabled = map[string]string{
+	"O": "ENABLED",
+	"D": "DISABLED",
+	"R": "REPLICA",
+	"A": "ALWAYS",
+}
+
+// Trigger asserts tr TriggerInfo is valid.
+func (a *DbAsserts) Trigger(tr TriggerInfo) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	dbTrigger, err := a.getTrigger(qualifyName(tr.Schema, tr.TableName), tr.Name)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	want := tr
+	want.Timing = strings.ToUpper(want.Timing)
+	want.Events = sortedStrings(upperStrings(want.Events))
+	want.Level = defaultString(strings.ToUpper(want.Level), "STATEMENT")
+	want.Enabled = defaultString(strings.ToUpper(want.Enabled), "ENABLED")
+	if len(want.UpdateColumns) == 0 {
+		want.UpdateColumns = nil
+	}
+	dbTrigger.Events = sortedStrings(dbTrigger.Events)
+	dbTrigger.TableName = tr.TableName
+	if tr.Schema == "" {
+		dbTrigger.Schema = ""
+	}
+	if schema, _ := splitName(tr.Function); schema == "" {
+		_, dbTrigger.Function = splitName(dbTrigger.Function)
+	}
+	return assert.Equal(a.T, want, *dbTrigger, "%s: trigger %s is not valid", tr.TableName, tr.Name)
+}
+
+func (a *DbAsserts) getTrigger(tableName, triggerName string) (*TriggerInfo, error) {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, table, err := a.resolveTable(tableName)
+	if err != nil {
+		return nil, err
+	}
+	const query = `
+select
+	t.tgtype,
+	t.tgenabled,
+	pn.nspname || '.' || p.proname,
+	array(
+		select a.attname::text
+		from unnest(t.tgattr) with ordinality as k(attnum, ord)
+		join pg_attribute a on a.attrelid = t.tgrelid and a.attnum = k.attnum
+		order by k.ord
+	)
+from pg_trigger t
+join pg_class c on c.oid = t.tgrelid
+join pg_namespace n on n.oid = c.relnamespace
+join pg_proc p on p.oid = t.tgfoid
+join pg_namespace pn on pn.oid = p.pronamespace
+where n.nspname = $1 and c.relname = $2 and t.tgname = $3 and not t.tgisinternal`
+	tr := TriggerInfo{
+		Schema:    schema,
+		TableName: tableName,
+		Name:      triggerName,
+	}
+	var tgType int
+	var enabled string
+	err = a.Db.QueryRow(query, schema, table, triggerName).Scan(&tgType, &enabled, &tr.Function, pq.Array(&tr.UpdateColumns))
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, fmt.Errorf("%s: trigger %s not found", tableName, triggerName)
+	case err != nil:
+		return nil, err
+	}
+	switch {
+	case tgType&triggerTypeBefore != 0:
+		tr.Timing = "BEFORE"
+	case tgType&triggerTypeInstead != 0:
+		tr.Timing = "INSTEAD OF"
+	default:
+		tr.Timing = "AFTER"
+	}
+	for _, e := range []struct {
+		bit   int
+		event string
+	}{
+		{triggerTypeInsert, "INSERT"},
+		{triggerTypeUpdate, "UPDATE"},
+		{triggerTypeDelete, "DELETE"},
+		{triggerTypeTruncate, "TRUNCATE"},
+	} {
+		if tgType&e.bit != 0 {
+			tr.Events = append(tr.Events, e.event)
+		}
+	}
+	tr.Level = "STATEMENT"
+	if tgType&triggerTypeRow != 0 {
+		tr.Level = "ROW"
+	}
+	tr.Enabled = triggerEnabled[enabled]
+	if len(tr.UpdateColumns) == 0 {
+		tr.UpdateColumns = nil
+	}
+	return &tr, nil
+}
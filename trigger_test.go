@@ -0,0 +1,183 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"testing"
+)
+
+func TestDbAsserts_Trigger(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	cases := []struct {
+		name string
+		tr   TriggerInfo
+		want bool
+	}{
+		{
+			name: "row",
+			tr: TriggerInfo{
+				TableName:     "test_trigger_dbasserts",
+				Name:          "test_update_time_trigger",
+				Timing:        "BEFORE",
+				Events:        []string{"UPDATE"},
+				UpdateColumns: []string{"name"},
+				Level:         "ROW",
+				Function:      "test_update_time_dbasserts",
+			},
+			want: true,
+		},
+		{
+			name: "statement",
+			tr: TriggerInfo{
+				TableName: "test_trigger_dbasserts",
+				Name:      "test_insert_delete_trigger",
+				Timing:    "after",
+				Events:    []string{"delete", "insert"},
+				Level:     "statement",
+				Enabled:   "disabled",
+				Function:  "public.test_update_time_dbasserts",
+			},
+			want: true,
+		},
+		{
+			name: "bad-timing",
+			tr: TriggerInfo{
+				TableName:     "test_trigger_dbasserts",
+				Name:          "test_update_time_trigger",
+				Timing:        "AFTER",
+				Events:        []string{"UPDATE"},
+				UpdateColumns: []string{"name"},
+				Level:         "ROW",
+				Function:      "test_update_time_dbasserts",
+			},
+			want: false,
+		},
+		{
+			name: "missing-update-columns",
+			tr: TriggerInfo{
+				TableName: "test_trigger_dbasserts",
+				Name:      "test_update_time_trigger",
+				Timing:    "BEFORE",
+				Events:    []string{"UPDATE"},
+				Level:     "ROW",
+				Function:  "test_update_time_dbasserts",
+			},
+			want: false,
+		},
+		{
+			name: "bad-events",
+			tr: TriggerInfo{
+				TableName: "test_trigger_dbasserts",
+				Name:      "test_insert_delete_trigger",
+				Timing:    "after",
+				Events:    []string{"INSERT"},
+				Level:     "statement",
+				Enabled:   "disabled",
+				Function:  "public.test_update_time_dbasserts",
+			},
+			want: false,
+		},
+		{
+			name: "bad-level",
+			tr: TriggerInfo{
+				TableName:     "test_trigger_dbasserts",
+				Name:          "test_update_time_trigger",
+				Timing:        "BEFORE",
+				Events:        []string{"UPDATE"},
+				UpdateColumns: []string{"name"},
+				Function:      "test_update_time_dbasserts",
+			},
+			want: false,
+		},
+		{
+			name: "bad-enabled",
+			tr: TriggerInfo{
+				TableName: "test_trigger_dbasserts",
+				Name:      "test_insert_delete_trigger",
+				Timing:    "after",
+				Events:    []string{"delete", "insert"},
+				Level:     "statement",
+				Function:  "public.test_update_time_dbasserts",
+			},
+			want: false,
+		},
+		{
+			name: "bad-function",
+			tr: TriggerInfo{
+				TableName:     "test_trigger_dbasserts",
+				Name:          "test_update_time_trigger",
+				Timing:        "BEFORE",
+				Events:        []string{"UPDATE"},
+				UpdateColumns: []string{"name"},
+				Level:         "ROW",
+				Function:      "bad_function",
+			},
+			want: false,
+		},
+		{
+			name: "bad-function-schema",
+			tr: TriggerInfo{
+				TableName:     "test_trigger_dbasserts",
+				Name:          "test_update_time_trigger",
+				Timing:        "BEFORE",
+				Events:        []string{"UPDATE"},
+				UpdateColumns: []string{"name"},
+				Level:         "ROW",
+				Function:      "dbasserts_audit.test_update_time_dbasserts",
+			},
+			want: false,
+		},
+		{
+			name: "bad-trigger",
+			tr: TriggerInfo{
+				TableName:     "test_trigger_dbasserts",
+				Name:          "bad_trigger",
+				Timing:        "BEFORE",
+				Events:        []string{"UPDATE"},
+				UpdateColumns: []string{"name"},
+				Level:         "ROW",
+				Function:      "test_update_time_dbasserts",
+			},
+			want: false,
+		},
+		{
+			name: "bad-table",
+			tr: TriggerInfo{
+				TableName:     "bad_table",
+				Name:          "test_update_time_trigger",
+				Timing:        "BEFORE",
+				Events:        []string{"UPDATE"},
+				UpdateColumns: []string{"name"},
+				Level:         "ROW",
+				Function:      "test_update_time_dbasserts",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := a.Trigger(tt.tr); got != tt.want {
+				t.Errorf("Trigger() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
@@ -0,0 +1,278 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// CompositeInfo defines a set of information about a composite type.
+type CompositeInfo struct {
+	// Schema of the composite type. When empty, the schema is resolved from
+	// Name or the DbAsserts Schema and isn't compared by Composite.
+	Schema string
+
+	// Name of the composite type, optionally qualified with its schema
+	// (schema.type).
+	Name string
+
+	// Attributes of the composite type, in order.
+	Attributes []AttributeInfo
+}
+
+// AttributeInfo defines an attribute of a composite type.
+type AttributeInfo struct {
+	// Name of the attribute.
+	Name string
+
+	// Type of the attribute as returned by format_type, for example integer
+	// or character varying(32). It's normalized by the database, so
+	// varchar(32) matches character varying(32).
+	Type string
+}
+
+// typeSchemasQuery finds the schemas containing a user-defined type named
+// $1. Built-in types, the row types of tables and views and array types
+// aren't user-defined types.
+const typeSchemasQuery = `
+select n.nspname
+from pg_type t
+join pg_namespace n on n.oid = t.typnamespace
+left join pg_class c on c.oid = t.typrelid
+where t.typname = $1
+	and n.nspname not in ('pg_catalog', 'information_schema')
+	and (t.typrelid = 0 or c.relkind = 'c')
+	and not (t.typelem <> 0 and t.typlen = -1)
+order by n.nspname`
+
+// Enum asserts typeName is an enum type with exactly labels, in their sort
+// order.
+func (a *DbAsserts) Enum(typeName string, labels ...string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	dbLabels, err := a.getEnumLabels(typeName)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	return assert.Equal(a.T, labels, dbLabels, "%s: enum labels are not valid", typeName)
+}
+
+// EnumColumn asserts colName in tableName is the enum type typeName. When
+// typeName isn't qualified with its schema, only its name is compared.
+func (a *DbAsserts) EnumColumn(tableName, colName, typeName string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	dbType, err := a.getColumnType(tableName, colName)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	if dbType.typtype != "e" {
+		assert.Fail(a.T, "column is not an enum", "%s: %s is %s which is not an enum", tableName, colName, dbType.name())
+		return false
+	}
+	if schema, _ := splitName(typeName); schema == "" {
+		dbType.schema = ""
+	}
+	if typeName == dbType.name() {
+		return true
+	}
+	assert.Fail(a.T, "enum is not valid", "%s: %s is %s not %s", tableName, colName, dbType.name(), typeName)
+	return false
+}
+
+// Composite asserts c CompositeInfo is valid and is a composite type created
+// with CREATE TYPE.
+func (a *DbAsserts) Composite(c CompositeInfo) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	dbComposite, err := a.getComposite(qualifyName(c.Schema, c.Name))
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	want := c
+	want.Attributes = nil
+	for _, attr := range c.Attributes {
+		if attr.Type, _, err = a.normalizeColumnType(attr.Type); err != nil {
+			assert.FailNow(a.T, err.Error())
+			return false
+		}
+		want.Attributes = append(want.Attributes, attr)
+	}
+	dbComposite.Name = c.Name
+	if c.Schema == "" {
+		dbComposite.Schema = ""
+	}
+	return assert.Equal(a.T, want, *dbComposite, "%s: composite type is not valid", c.Name)
+}
+
+// CompositeColumn asserts colName in tableName is the composite type
+// typeName. When typeName isn't qualified with its schema, only its name is
+// compared.
+func (a *DbAsserts) CompositeColumn(tableName, colName, typeName string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	dbType, err := a.getColumnType(tableName, colName)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	if dbType.typtype != "c" {
+		assert.Fail(a.T, "column is not a composite", "%s: %s is %s which is not a composite", tableName, colName, dbType.name())
+		return false
+	}
+	if schema, _ := splitName(typeName); schema == "" {
+		dbType.schema = ""
+	}
+	if typeName == dbType.name() {
+		return true
+	}
+	assert.Fail(a.T, "composite is not valid", "%s: %s is %s not %s", tableName, colName, dbType.name(), typeName)
+	return false
+}
+
+// typeInfo defines a type.
+type typeInfo struct {
+	schema  string
+	typname string
+	typtype string
+	relkind string
+}
+
+// name returns the type's name, qualified with its schema when set.
+func (t typeInfo) name() string {
+	return qualifyName(t.schema, t.typname)
+}
+
+func (a *DbAsserts) getEnumLabels(typeName string) ([]string, error) {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	typ, err := a.getType(typeName)
+	if err != nil {
+		return nil, err
+	}
+	if typ.typtype != "e" {
+		return nil, fmt.Errorf("%s is not an enum", typeName)
+	}
+	const query = `
+select e.enumlabel
+from pg_enum e
+join pg_type t on t.oid = e.enumtypid
+join pg_namespace n on n.oid = t.typnamespace
+where n.nspname = $1 and t.typname = $2
+order by e.enumsortorder`
+	return a.queryStrings(query, typ.schema, typ.typname)
+}
+
+func (a *DbAsserts) getComposite(typeName string) (*CompositeInfo, error) {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	typ, err := a.getType(typeName)
+	if err != nil {
+		return nil, err
+	}
+	if typ.typtype != "c" || typ.relkind != "c" {
+		return nil, fmt.Errorf("%s is not a composite type", typeName)
+	}
+	const query = `
+select a.attname, format_type(a.atttypid, a.atttypmod)
+from pg_type t
+join pg_namespace n on n.oid = t.typnamespace
+join pg_attribute a on a.attrelid = t.typrelid
+where n.nspname = $1
+	and t.typname = $2
+	and a.attnum > 0
+	and not a.attisdropped
+order by a.attnum`
+	rows, err := a.Db.Query(query, typ.schema, typ.typname)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	composite := CompositeInfo{
+		Schema: typ.schema,
+		Name:   typ.typname,
+	}
+	for rows.Next() {
+		var attr AttributeInfo
+		if err := rows.Scan(&attr.Name, &attr.Type); err != nil {
+			return nil, err
+		}
+		composite.Attributes = append(composite.Attributes, attr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return &composite, nil
+}
+
+func (a *DbAsserts) getType(typeName string) (*typeInfo, error) {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, name, err := a.resolveName("type", typeName, typeSchemasQuery)
+	if err != nil {
+		return nil, err
+	}
+	const query = `
+select t.typtype, coalesce(c.relkind, '')
+from pg_type t
+join pg_namespace n on n.oid = t.typnamespace
+left join pg_class c on c.oid = t.typrelid
+where n.nspname = $1 and t.typname = $2`
+	typ := typeInfo{
+		schema:  schema,
+		typname: name,
+	}
+	err = a.Db.QueryRow(query, schema, name).Scan(&typ.typtype, &typ.relkind)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, fmt.Errorf("type %s not found", typeName)
+	case err != nil:
+		return nil, err
+	}
+	return &typ, nil
+}
+
+func (a *DbAsserts) getColumnType(tableName, colName string) (*typeInfo, error) {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, table, err := a.resolveTable(tableName)
+	if err != nil {
+		return nil, err
+	}
+	const query = `
+select tn.nspname, t.typname, t.typtype
+from pg_attribute a
+join pg_class c on c.oid = a.attrelid
+join pg_namespace n on n.oid = c.relnamespace
+join pg_type t on t.oid = a.atttypid
+join pg_namespace tn on tn.oid = t.typnamespace
+where n.nspname = $1
+	and c.relname = $2
+	and a.attname = $3
+	and a.attnum > 0
+	and not a.attisdropped`
+	var typ typeInfo
+	err = a.Db.QueryRow(query, schema, table, colName).Scan(&typ.schema, &typ.typname, &typ.typtype)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, fmt.Errorf("%s: column %s not found", tableName, colName)
+	case err != nil:
+		return nil, err
+	}
+	return &typ, nil
+}
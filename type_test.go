@@ -0,0 +1,359 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"testing"
+)
+
+func TestDbAsserts_Enum(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	cases := []struct {
+		name     string
+		typeName string
+		labels   []string
+		want     bool
+	}{
+		{
+			name:     "enum",
+			typeName: "test_status_dbasserts",
+			labels:   []string{"pending", "active", "closed"},
+			want:     true,
+		},
+		{
+			name:     "qualified",
+			typeName: "public.test_status_dbasserts",
+			labels:   []string{"pending", "active", "closed"},
+			want:     true,
+		},
+		{
+			name:     "bad-order",
+			typeName: "test_status_dbasserts",
+			labels:   []string{"active", "pending", "closed"},
+			want:     false,
+		},
+		{
+			name:     "missing-label",
+			typeName: "test_status_dbasserts",
+			labels:   []string{"pending", "active"},
+			want:     false,
+		},
+		{
+			name:     "not-enum",
+			typeName: "dbasserts_public_id",
+			labels:   []string{"pending"},
+			want:     false,
+		},
+		{
+			name:     "bad-type",
+			typeName: "bad_type",
+			labels:   []string{"pending"},
+			want:     false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := a.Enum(tt.typeName, tt.labels...); got != tt.want {
+				t.Errorf("Enum() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
+
+func TestDbAsserts_EnumColumn(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	cases := []struct {
+		name      string
+		tableName string
+		colName   string
+		typeName  string
+		want      bool
+	}{
+		{
+			name:      "enum",
+			tableName: "test_type_dbasserts",
+			colName:   "status",
+			typeName:  "test_status_dbasserts",
+			want:      true,
+		},
+		{
+			name:      "qualified",
+			tableName: "test_type_dbasserts",
+			colName:   "status",
+			typeName:  "public.test_status_dbasserts",
+			want:      true,
+		},
+		{
+			name:      "bad-schema",
+			tableName: "test_type_dbasserts",
+			colName:   "status",
+			typeName:  "dbasserts_audit.test_status_dbasserts",
+			want:      false,
+		},
+		{
+			name:      "bad-enum",
+			tableName: "test_type_dbasserts",
+			colName:   "status",
+			typeName:  "bad_enum",
+			want:      false,
+		},
+		{
+			name:      "not-enum",
+			tableName: "test_type_dbasserts",
+			colName:   "name",
+			typeName:  "test_status_dbasserts",
+			want:      false,
+		},
+		{
+			name:      "bad-column",
+			tableName: "test_type_dbasserts",
+			colName:   "bad_column",
+			typeName:  "test_status_dbasserts",
+			want:      false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := a.EnumColumn(tt.tableName, tt.colName, tt.typeName); got != tt.want {
+				t.Errorf("EnumColumn() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
+
+func TestDbAsserts_Composite(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	cases := []struct {
+		name string
+		c    CompositeInfo
+		want bool
+	}{
+		{
+			name: "composite",
+			c: CompositeInfo{
+				Name: "test_address_dbasserts",
+				Attributes: []AttributeInfo{
+					{Name: "street", Type: "text"},
+					{Name: "city", Type: "character varying(64)"},
+					{Name: "status", Type: "test_status_dbasserts"},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "qualified",
+			c: CompositeInfo{
+				Schema: "public",
+				Name:   "test_address_dbasserts",
+				Attributes: []AttributeInfo{
+					{Name: "street", Type: "text"},
+					{Name: "city", Type: "character varying(64)"},
+					{Name: "status", Type: "test_status_dbasserts"},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "varchar-attribute",
+			c: CompositeInfo{
+				Name: "test_address_dbasserts",
+				Attributes: []AttributeInfo{
+					{Name: "street", Type: "text"},
+					{Name: "city", Type: "varchar(64)"},
+					{Name: "status", Type: "test_status_dbasserts"},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "bad-order",
+			c: CompositeInfo{
+				Name: "test_address_dbasserts",
+				Attributes: []AttributeInfo{
+					{Name: "city", Type: "character varying(64)"},
+					{Name: "street", Type: "text"},
+					{Name: "status", Type: "test_status_dbasserts"},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "bad-type",
+			c: CompositeInfo{
+				Name: "test_address_dbasserts",
+				Attributes: []AttributeInfo{
+					{Name: "street", Type: "text"},
+					{Name: "city", Type: "character varying(32)"},
+					{Name: "status", Type: "test_status_dbasserts"},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "missing-attribute",
+			c: CompositeInfo{
+				Name: "test_address_dbasserts",
+				Attributes: []AttributeInfo{
+					{Name: "street", Type: "text"},
+					{Name: "city", Type: "character varying(64)"},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "table-row-type",
+			c: CompositeInfo{
+				Name: "test_type_dbasserts",
+			},
+			want: false,
+		},
+		{
+			name: "enum",
+			c: CompositeInfo{
+				Name: "test_status_dbasserts",
+			},
+			want: false,
+		},
+		{
+			name: "bad-composite",
+			c: CompositeInfo{
+				Name: "bad_composite",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := a.Composite(tt.c); got != tt.want {
+				t.Errorf("Composite() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
+
+func TestDbAsserts_CompositeColumn(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	cases := []struct {
+		name      string
+		tableName string
+		colName   string
+		typeName  string
+		want      bool
+	}{
+		{
+			name:      "composite",
+			tableName: "test_type_dbasserts",
+			colName:   "address",
+			typeName:  "test_address_dbasserts",
+			want:      true,
+		},
+		{
+			name:      "qualified",
+			tableName: "test_type_dbasserts",
+			colName:   "address",
+			typeName:  "public.test_address_dbasserts",
+			want:      true,
+		},
+		{
+			name:      "bad-composite",
+			tableName: "test_type_dbasserts",
+			colName:   "address",
+			typeName:  "bad_composite",
+			want:      false,
+		},
+		{
+			name:      "not-composite",
+			tableName: "test_type_dbasserts",
+			colName:   "status",
+			typeName:  "test_address_dbasserts",
+			want:      false,
+		},
+		{
+			name:      "bad-column",
+			tableName: "test_type_dbasserts",
+			colName:   "bad_column",
+			typeName:  "test_address_dbasserts",
+			want:      false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := a.CompositeColumn(tt.tableName, tt.colName, tt.typeName); got != tt.want {
+				t.Errorf("CompositeColumn() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+// ViewInfo defines a set of information about a view or materialized view.
+type ViewInfo struct {
+	// Schema of the view. When empty, the schema is resolved from Name or
+	// the DbAsserts Schema and isn't compared.
+	Schema string
+
+	// Name of the view, optionally qualified with its schema (schema.view).
+	Name string
+
+	// Columns of the view, in order. When empty, they aren't compared.
+	// Their Schema and TableName aren't compared, and each column is
+	// otherwise compared like Column.
+	Columns []ColumnInfo
+
+	// IsSecurityInvoker defines if the view has the security_invoker
+	// option.
+	IsSecurityInvoker bool
+
+	// IsSecurityBarrier defines if the view has the security_barrier
+	// option.
+	IsSecurityBarrier bool
+
+	// IsConcurrentlyRefreshable defines if the materialized view has a
+	// unique index which allows REFRESH MATERIALIZED VIEW CONCURRENTLY.
+	IsConcurrentlyRefreshable bool
+
+	// Definition of the view. When empty, it isn't compared. It's compared
+	// after being normalized by the database, so it doesn't need to match
+	// the text returned by pg_get_viewdef.
+	Definition string
+}
+
+// viewScratchName names the scratch view used to normalize definitions.
+const viewScratchName = "dbassert_view"
+
+// View asserts v ViewInfo is valid and is a view.
+func (a *DbAsserts) View(v ViewInfo) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	return a.assertView(v, "v", "view")
+}
+
+// MaterializedView asserts v ViewInfo is valid and is a materialized view.
+func (a *DbAsserts) MaterializedView(v ViewInfo) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	return a.assertView(v, "m", "materialized view")
+}
+
+func (a *DbAsserts) assertView(v ViewInfo, relkind, kind string) bool {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	dbView, err := a.getView(qualifyName(v.Schema, v.Name), relkind, kind)
+	if err != nil {
+		assert.FailNow(a.T, err.Error())
+		return false
+	}
+	want := v
+	if want.Definition != "" {
+		if want.Definition, err = a.normalizeViewDefinition(want.Definition); err != nil {
+			assert.FailNow(a.T, err.Error())
+			return false
+		}
+	} else {
+		dbView.Definition = ""
+	}
+	if len(want.Columns) > 0 {
+		if want.Columns, err = a.wantViewColumns(want.Columns, dbView.Columns); err != nil {
+			assert.FailNow(a.T, err.Error())
+			return false
+		}
+	} else {
+		dbView.Columns = nil
+	}
+	want.Columns = unqualifiedColumns(want.Columns)
+	dbView.Columns = unqualifiedColumns(dbView.Columns)
+	dbView.Name = v.Name
+	if v.Schema == "" {
+		dbView.Schema = ""
+	}
+	return assert.Equal(a.T, want, *dbView, "%s: %s is not valid", v.Name, kind)
+}
+
+func (a *DbAsserts) getView(name, relkind, kind string) (*ViewInfo, error) {
+	if h, ok := a.T.(THelper); ok {
+		h.Helper()
+	}
+	schema, viewName, err := a.resolveTable(name)
+	if err != nil {
+		return nil, err
+	}
+	const query = `
+select
+	c.relkind,
+	coalesce(c.reloptions, '{}'),
+	pg_get_viewdef(c.oid, true),
+	exists(
+		select 1
+		from pg_index ix
+		where ix.indrelid = c.oid
+			and ix.indisunique
+			and ix.indpred is null
+			and ix.indexprs is null
+	)
+from pg_class c
+join pg_namespace n on n.oid = c.relnamespace
+where n.nspname = $1 and c.relname = $2`
+	v := ViewInfo{
+		Schema: schema,
+		Name:   name,
+	}
+	var dbRelkind string
+	var options []string
+	err = a.Db.QueryRow(query, schema, viewName).Scan(&dbRelkind, pq.Array(&options), &v.Definition, &v.IsConcurrentlyRefreshable)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, fmt.Errorf("%s %s not found", kind, name)
+	case err != nil:
+		return nil, err
+	case dbRelkind != relkind:
+		return nil, fmt.Errorf("%s is not a %s", name, kind)
+	}
+	for _, o := range options {
+		option, value, _ := strings.Cut(o, "=")
+		switch option {
+		case "security_invoker":
+			v.IsSecurityInvoker = isTrue(value)
+		case "security_barrier":
+			v.IsSecurityBarrier = isTrue(value)
+		}
+	}
+	if v.Columns, err = a.getColumns(qualifyName(schema, viewName)); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// normalizeViewDefinition has the database render definition by creating a
+// scratch view from it.
+func (a *DbAsserts) normalizeViewDefinition(definition string) (string, error) {
+	var normalized string
+	err := a.withRollback(func(tx *sql.Tx) error {
+		create := fmt.Sprintf("create temp view %s as %s", viewScratchName, definition)
+		if _, err := tx.Exec(create); err != nil {
+			return fmt.Errorf("invalid view definition %q: %w", definition, err)
+		}
+		query := fmt.Sprintf("select pg_get_viewdef('pg_temp.%s'::regclass, true)", viewScratchName)
+		return tx.QueryRow(query).Scan(&normalized)
+	})
+	return normalized, err
+}
+
+// wantViewColumns returns a copy of columns normalized like Column, and
+// clears the fields of dbColumns which aren't compared with them. Columns
+// are matched by their position.
+func (a *DbAsserts) wantViewColumns(columns, dbColumns []ColumnInfo) ([]ColumnInfo, error) {
+	want := make([]ColumnInfo, 0, len(columns))
+	for i, c := range columns {
+		if i < len(dbColumns) {
+			var err error
+			if c, err = a.wantColumn(c, &dbColumns[i]); err != nil {
+				return nil, err
+			}
+			dbColumns[i] = *comparableColumn(c, &dbColumns[i])
+		}
+		want = append(want, c)
+	}
+	return want, nil
+}
+
+// unqualifiedColumns returns a copy of columns without their Schema and
+// TableName.
+func unqualifiedColumns(columns []ColumnInfo) []ColumnInfo {
+	if len(columns) == 0 {
+		return nil
+	}
+	unqualified := make([]ColumnInfo, 0, len(columns))
+	for _, c := range columns {
+		c.Schema, c.TableName = "", ""
+		unqualified = append(unqualified, c)
+	}
+	return unqualified
+}
+
+// isTrue returns true when value is a postgres boolean true.
+func isTrue(value string) bool {
+	switch strings.ToLower(value) {
+	case "true", "on", "yes", "1", "t", "y":
+		return true
+	}
+	return false
+}
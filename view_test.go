@@ -0,0 +1,270 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dbassert
+
+import (
+	"testing"
+)
+
+func TestDbAsserts_View(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	cases := []struct {
+		name string
+		v    ViewInfo
+		want bool
+	}{
+		{
+			name: "view",
+			v: ViewInfo{
+				Name: "test_view_dbasserts",
+				Columns: []ColumnInfo{
+					{Name: "id", Type: "bigint", IsNullable: true},
+					{Name: "public_id", Type: "text", DomainName: "dbasserts_public_id", IsNullable: true},
+					{Name: "nullable", Type: "text", IsNullable: true},
+				},
+				IsSecurityInvoker: true,
+				IsSecurityBarrier: true,
+			},
+			want: true,
+		},
+		{
+			name: "definition",
+			v: ViewInfo{
+				Schema: "public",
+				Name:   "test_view_dbasserts",
+				Columns: []ColumnInfo{
+					{Name: "id", Type: "bigint", IsNullable: true},
+					{Name: "public_id", Type: "text", DomainName: "dbasserts_public_id", IsNullable: true},
+					{Name: "nullable", Type: "text", IsNullable: true},
+				},
+				IsSecurityInvoker: true,
+				IsSecurityBarrier: true,
+				Definition:        "select id, public_id, nullable from test_table_dbasserts where type_int is not null",
+			},
+			want: true,
+		},
+		{
+			name: "bad-definition",
+			v: ViewInfo{
+				Name: "test_view_dbasserts",
+				Columns: []ColumnInfo{
+					{Name: "id", Type: "bigint", IsNullable: true},
+					{Name: "public_id", Type: "text", DomainName: "dbasserts_public_id", IsNullable: true},
+					{Name: "nullable", Type: "text", IsNullable: true},
+				},
+				IsSecurityInvoker: true,
+				IsSecurityBarrier: true,
+				Definition:        "select id, public_id, nullable from test_table_dbasserts",
+			},
+			want: false,
+		},
+		{
+			name: "bad-column-order",
+			v: ViewInfo{
+				Name: "test_view_dbasserts",
+				Columns: []ColumnInfo{
+					{Name: "public_id", Type: "text", DomainName: "dbasserts_public_id", IsNullable: true},
+					{Name: "id", Type: "bigint", IsNullable: true},
+					{Name: "nullable", Type: "text", IsNullable: true},
+				},
+				IsSecurityInvoker: true,
+				IsSecurityBarrier: true,
+			},
+			want: false,
+		},
+		{
+			name: "missing-column",
+			v: ViewInfo{
+				Name: "test_view_dbasserts",
+				Columns: []ColumnInfo{
+					{Name: "id", Type: "bigint", IsNullable: true},
+					{Name: "public_id", Type: "text", DomainName: "dbasserts_public_id", IsNullable: true},
+				},
+				IsSecurityInvoker: true,
+				IsSecurityBarrier: true,
+			},
+			want: false,
+		},
+		{
+			name: "not-security-invoker",
+			v: ViewInfo{
+				Name: "test_view_dbasserts",
+				Columns: []ColumnInfo{
+					{Name: "id", Type: "bigint", IsNullable: true},
+					{Name: "public_id", Type: "text", DomainName: "dbasserts_public_id", IsNullable: true},
+					{Name: "nullable", Type: "text", IsNullable: true},
+				},
+				IsSecurityBarrier: true,
+			},
+			want: false,
+		},
+		{
+			name: "column-types",
+			v: ViewInfo{
+				Name: "test_column_types_view_dbasserts",
+				Columns: []ColumnInfo{
+					{Name: "code", Type: "character varying", IsNullable: true, UdtName: "varchar", CharMaxLength: 32, Collation: "C"},
+					{Name: "amount", Type: "numeric", IsNullable: true, NumericPrecision: 10, NumericScale: 2},
+					{Name: "tags", Type: "ARRAY", IsNullable: true, UdtName: "_text", ElementType: "text"},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "bad-column-types",
+			v: ViewInfo{
+				Name: "test_column_types_view_dbasserts",
+				Columns: []ColumnInfo{
+					{Name: "code", Type: "character varying", IsNullable: true, CharMaxLength: 255},
+					{Name: "amount", Type: "numeric", IsNullable: true, CompareModifiers: true},
+					{Name: "tags", Type: "ARRAY", IsNullable: true, ElementType: "integer"},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "materialized-view",
+			v: ViewInfo{
+				Name: "test_matview_dbasserts",
+			},
+			want: false,
+		},
+		{
+			name: "table",
+			v: ViewInfo{
+				Name: "test_table_dbasserts",
+			},
+			want: false,
+		},
+		{
+			name: "bad-view",
+			v: ViewInfo{
+				Name: "bad_view",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := a.View(tt.v); got != tt.want {
+				t.Errorf("View() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}
+
+func TestDbAsserts_MaterializedView(t *testing.T) {
+	t.Parallel()
+	cleanup, conn, _ := TestSetup(t, "postgres")
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+		if err := conn.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	cases := []struct {
+		name string
+		v    ViewInfo
+		want bool
+	}{
+		{
+			name: "materialized-view",
+			v: ViewInfo{
+				Name: "test_matview_dbasserts",
+				Columns: []ColumnInfo{
+					{Name: "id", Type: "bigint", IsNullable: true},
+					{Name: "public_id", Type: "text", DomainName: "dbasserts_public_id", IsNullable: true},
+				},
+				IsConcurrentlyRefreshable: true,
+			},
+			want: true,
+		},
+		{
+			name: "definition",
+			v: ViewInfo{
+				Name: "test_matview_dbasserts",
+				Columns: []ColumnInfo{
+					{Name: "id", Type: "bigint", IsNullable: true},
+					{Name: "public_id", Type: "text", DomainName: "dbasserts_public_id", IsNullable: true},
+				},
+				IsConcurrentlyRefreshable: true,
+				Definition:                "select id, public_id from test_table_dbasserts",
+			},
+			want: true,
+		},
+		{
+			name: "without-columns",
+			v: ViewInfo{
+				Name:                      "test_matview_dbasserts",
+				IsConcurrentlyRefreshable: true,
+			},
+			want: true,
+		},
+		{
+			name: "no-unique-index",
+			v: ViewInfo{
+				Name: "test_matview_no_idx_dbasserts",
+				Columns: []ColumnInfo{
+					{Name: "id", Type: "bigint", IsNullable: true},
+				},
+				IsConcurrentlyRefreshable: true,
+			},
+			want: false,
+		},
+		{
+			name: "bad-columns",
+			v: ViewInfo{
+				Name: "test_matview_dbasserts",
+				Columns: []ColumnInfo{
+					{Name: "public_id", Type: "text", DomainName: "dbasserts_public_id", IsNullable: true},
+				},
+				IsConcurrentlyRefreshable: true,
+			},
+			want: false,
+		},
+		{
+			name: "view",
+			v: ViewInfo{
+				Name: "test_view_dbasserts",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockery := new(MockTesting)
+			a := New(mockery, conn, "postgres")
+
+			if got := a.MaterializedView(tt.v); got != tt.want {
+				t.Errorf("MaterializedView() = %v, want %v", got, tt.want)
+			}
+			switch {
+			case tt.want:
+				mockery.AssertNoError(t)
+			default:
+				mockery.AssertError(t)
+			}
+		})
+	}
+}